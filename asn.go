@@ -131,44 +131,44 @@ Case is significant during processing of the above abbreviations.  Note that it
 inappropriate to utilize these abbreviations for any portion of an [ASN1Notation]
 instance other than as the respective root node.
 
+Optionally, a [Resolver] -- such as a *[Registry] -- may be supplied, which shall be
+consulted for any non-root arc bearing a name but no number (e.g.: "dod"). If no such
+[Resolver] is supplied, or if it cannot resolve the arc, an error naming the arc is
+returned.
+
 [NumberForm] values CANNOT be negative, but are unbounded in their magnitude.
+
+Any error returned is a *[ParseError], which wraps one of the exported parsing
+errors (e.g.: [ErrUnresolvedArc]) for use with [errors.Is].
 */
-func NewASN1Notation(x any) (r *ASN1Notation, err error) {
+func NewASN1Notation(x any, resolver ...Resolver) (r *ASN1Notation, err error) {
 	// prepare temporary instance
 	t := make(ASN1Notation, 0)
 	r = new(ASN1Notation)
 
+	var input string
 	var nfs []string
 	switch tv := x.(type) {
 	case []NameAndNumberForm:
 		t = ASN1Notation(tv)
-		if !t.Valid() {
-			err = errorf("%T instance did not pass validity checks: %#v", t, t)
-			break
+		if err = t.validate(t.String(), nil); err == nil {
+			*r = t
 		}
-		*r = t
 		return
 	case string:
+		input = tv
 		nfs = fields(condenseWHSP(trimR(trimL(tv, `{`), `}`)))
 	case []string:
+		input = join(tv, ` `)
 		nfs = tv
 	default:
-		err = errorf("Unsupported %T input type: %#v", x, x)
+		err = parseErr(ErrUnsupportedType, sprintf("%T", x), -1, -1)
 		return
 	}
 
-	for i := 0; i < len(nfs); i++ {
-		var nanf *NameAndNumberForm
-		if nanf, err = NewNameAndNumberForm(nfs[i]); err != nil {
-			break
-		}
-		t = append(t, *nanf)
-	}
-
-	if err == nil {
+	if t, err = parseArcs(input, nfs, resolver, false); err == nil {
 		// verify content is valid
-		if !t.Valid() {
-			err = errorf("%T instance did not pass validity checks: %#v", t, t)
+		if err = t.validate(input, arcOffsets(input, nfs)); err != nil {
 			return
 		}
 
@@ -183,6 +183,9 @@ func NewASN1Notation(x any) (r *ASN1Notation, err error) {
 /*
 Valid returns a Boolean value indicative of whether the receiver's
 length is greater than or equal to one (1) slice member.
+
+Additionally, any [NameAndNumberForm] designated as a long arc must
+reside directly beneath joint-iso-itu-t(2).
 */
 func (r ASN1Notation) Valid() (is bool) {
 	// Don't waste time on
@@ -190,13 +193,56 @@ func (r ASN1Notation) Valid() (is bool) {
 	if L := r.Len(); L > 0 {
 		if root, ok := r.Index(0); ok {
 			// root cannot be greater than 2
-			is = root.NumberForm().Lt(3)
+			is = root.NumberForm().Lt(3) && r.misplacedLongArc() == -1
 		}
 	}
 
 	return
 }
 
+/*
+validate returns an error describing the first reason for which the
+receiver would fail [ASN1Notation.Valid], if any. The input and offs
+values are used solely for error reporting; see [arcOffsets].
+*/
+func (r ASN1Notation) validate(input string, offs []int) (err error) {
+	off := func(i int) int {
+		if i < len(offs) {
+			return offs[i]
+		}
+		return -1
+	}
+
+	switch {
+	case r.Len() == 0:
+		err = parseErr(ErrEmpty, input, -1, -1)
+	case !r[0].parsed:
+		err = parseErr(ErrEmpty, input, 0, off(0))
+	case r[0].NumberForm().Gt(2):
+		err = parseErr(ErrInvalidRoot, input, 0, off(0))
+	default:
+		if i := r.misplacedLongArc(); i != -1 {
+			err = parseErr(ErrInvalidLongArc, input, i, off(i))
+		}
+	}
+
+	return
+}
+
+/*
+misplacedLongArc returns the index of the first long arc within the
+receiver which does not reside directly beneath joint-iso-itu-t(2),
+per ITU-T Rec. X.660, or -1 if there are none.
+*/
+func (r ASN1Notation) misplacedLongArc() int {
+	for i := 0; i < r.Len(); i++ {
+		if r[i].longArc && (i != 1 || !r[0].primaryIdentifier.Equal(2)) {
+			return i
+		}
+	}
+	return -1
+}
+
 /*
 Ancestry returns slices of [DotNotation] values ordered from leaf node
 (first) to root node (last).
@@ -0,0 +1,531 @@
+/*
+Command oid converts, encodes, decodes and inspects ASN.1 object identifiers.
+
+Usage:
+
+	oid <command> [flags] [value ...]
+
+The commands are:
+
+	convert   print the dot, ASN.1, hex DER and base64 DER forms of each value
+	encode    print the DER encoding of each value, in hex (or base64 with -base64)
+	decode    print the dot notation of each hex or base64 DER value
+	validate  report whether each value is a valid OID
+	ancestry  list the ancestors of each value, beginning with the value itself
+	relate    compare the first value with each subsequent value
+
+Values may take any of the following forms, and are read from standard input,
+one per line, if none are given as arguments:
+
+	1.3.6.1.4.1.56521                       dot notation
+	{iso(1) identified-organization(3) 6}   ASN.1 notation
+	{iso identified-organization dod}       ASN.1 notation, resolved by name
+	06032B0601 (or 0x06032b0601)            hex DER
+	BgMrBgE=                                base64 DER
+
+Hex takes precedence over base64 where a value could be either. Names are
+resolved, and supplied in output, using a registry of well-known arcs.
+
+For relate, the first value is the reference, and each subsequent value is
+reported in terms of the reference's AncestorOf, ChildOf and SiblingOf
+methods (e.g.: "ChildOf=true" indicates that the value is a direct child of
+the reference).
+
+The flags are:
+
+	-json    emit one (1) JSON object per value (JSON Lines)
+	-strict  reject non-DER encodings during decoding
+	-base64  emit base64 rather than hex (encode only)
+
+The exit status is 0 if all values were processed successfully (and, for
+validate, were valid), 1 if not, and 2 upon incorrect usage.
+*/
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/JesseCoretta/go-objectid"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+/*
+config contains the settings and streams shared by all commands.
+*/
+type config struct {
+	json     bool
+	strict   bool
+	base64   bool
+	stdout   io.Writer
+	stderr   io.Writer
+	registry *objectid.Registry
+	emitted  int // number of text blocks written
+}
+
+/*
+command describes a subcommand. The handle function processes a single
+input value, returning its result alongside an error.
+*/
+type command struct {
+	summary string
+	handle  func(*config, string) (result, error)
+}
+
+/*
+result is implemented by the output of each command.
+*/
+type result interface {
+	text() string // text form, sans trailing newline
+	ok() bool     // whether the exit status is unaffected
+}
+
+var commands = map[string]command{
+	`convert`:  {`print the dot, ASN.1, hex DER and base64 DER forms of each value`, convert},
+	`encode`:   {`print the DER encoding of each value`, encode},
+	`decode`:   {`print the dot notation of each hex or base64 DER value`, decode},
+	`validate`: {`report whether each value is a valid OID`, validate},
+	`ancestry`: {`list the ancestors of each value`, ancestry},
+	`relate`:   {`compare the first value with each subsequent value`, nil}, // see run
+}
+
+var commandOrder = []string{`convert`, `encode`, `decode`, `validate`, `ancestry`, `relate`}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: oid <command> [flags] [value ...]\n\nCommands:")
+	for _, name := range commandOrder {
+		fmt.Fprintf(w, "  %-9s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(w, "\nValues are read from standard input, one per line, if none are given.")
+}
+
+/*
+run executes the command described by args, returning the exit status.
+*/
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	name := args[0]
+	switch name {
+	case `help`, `-h`, `-help`, `--help`:
+		usage(stdout)
+		return 0
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(stderr, "oid: unknown command %q\n\n", name)
+		usage(stderr)
+		return 2
+	}
+
+	c := &config{stdout: stdout, stderr: stderr, registry: objectid.NewWellKnownRegistry()}
+
+	fs := flag.NewFlagSet(`oid `+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&c.json, `json`, false, `emit one JSON object per value`)
+	fs.BoolVar(&c.strict, `strict`, false, `reject non-DER encodings during decoding`)
+	if name == `encode` {
+		fs.BoolVar(&c.base64, `base64`, false, `emit base64 rather than hex`)
+	}
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	values := fs.Args()
+	handle := cmd.handle
+	if name == `relate` {
+		if len(values) == 0 {
+			fmt.Fprintln(stderr, "oid: relate requires a reference value")
+			return 2
+		}
+
+		ref, err := c.parse(values[0])
+		if err != nil {
+			fmt.Fprintf(stderr, "oid: %s: %v\n", values[0], err)
+			return 1
+		}
+		handle, values = relate(ref), values[1:]
+	}
+
+	status := 0
+	process := func(in string) {
+		if !c.emit(in, handle) {
+			status = 1
+		}
+	}
+
+	if len(values) > 0 {
+		for _, in := range values {
+			process(in)
+		}
+		return status
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if in := strings.TrimSpace(scanner.Text()); in != `` {
+			process(in)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "oid: %v\n", err)
+		status = 1
+	}
+
+	return status
+}
+
+/*
+emit processes in using handle, and writes the result or error in the
+configured format. A Boolean value indicative of success is returned.
+*/
+func (c *config) emit(in string, handle func(*config, string) (result, error)) bool {
+	res, err := handle(c, in)
+
+	if c.json {
+		var v any = res
+		if err != nil {
+			v = failure{Input: in, Error: err.Error()}
+		}
+		b, _ := json.Marshal(v)
+		fmt.Fprintf(c.stdout, "%s\n", b)
+	} else if err != nil {
+		fmt.Fprintf(c.stderr, "oid: %s: %v\n", in, err)
+	} else {
+		text := res.text()
+		if strings.Contains(text, "\n") {
+			// separate multi-line blocks.
+			if c.emitted > 0 {
+				fmt.Fprintln(c.stdout)
+			}
+			c.emitted++
+		}
+		fmt.Fprintln(c.stdout, text)
+	}
+
+	return err == nil && res.ok()
+}
+
+type failure struct {
+	Input string `json:"input"`
+	Error string `json:"error"`
+}
+
+/*
+value is a parsed input value.
+*/
+type value struct {
+	dot objectid.DotNotation
+	asn string // ASN.1 notation, if supplied as input
+}
+
+/*
+parse returns the value represented by in, which may be in any of the
+supported forms, alongside an error.
+*/
+func (c *config) parse(in string) (v value, err error) {
+	switch {
+	case strings.HasPrefix(in, `{`):
+		var oid *objectid.OID
+		if oid, err = objectid.NewOID(in, c.registry); err == nil {
+			v.dot, v.asn = oid.Dot(), oid.String()
+		}
+	case isDot(in):
+		var dot *objectid.DotNotation
+		if dot, err = objectid.NewDotNotation(in); err == nil {
+			v.dot = *dot
+		}
+	default:
+		v, err = c.decode(in)
+	}
+
+	return
+}
+
+/*
+decode returns the value encoded within in, which must be hex or base64
+DER, alongside an error.
+*/
+func (c *config) decode(in string) (v value, err error) {
+	var der []byte
+	if der, err = decodeDER(in); err != nil {
+		return
+	}
+
+	var opts []objectid.DecodeOption
+	if c.strict {
+		opts = append(opts, objectid.Strict)
+	}
+	err = v.dot.Decode(der, opts...)
+
+	return
+}
+
+/*
+asn returns the ASN.1 notation of v, naming those arcs known to the
+registry if v was not supplied in ASN.1 notation.
+*/
+func (c *config) asn(v value) string {
+	if v.asn != `` {
+		return v.asn
+	}
+
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := range v.dot {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		if e, ok := c.registry.LookupDot(v.dot[:i+1]); ok && e.NameAndNumberForm().Identifier() != `` {
+			fmt.Fprintf(&b, "%s(%s)", e.NameAndNumberForm().Identifier(), v.dot[i])
+		} else {
+			b.WriteString(v.dot[i].String())
+		}
+	}
+	b.WriteByte('}')
+
+	return b.String()
+}
+
+/*
+isDot returns a Boolean value indicative of whether in consists solely of
+digits and at least one (1) dot.
+*/
+func isDot(in string) bool {
+	return strings.Contains(in, `.`) && strings.Trim(in, `.0123456789`) == ``
+}
+
+/*
+decodeDER returns the octets represented by in, which may be hex (with or
+without a "0x" prefix, and optionally delimited by colons or whitespace)
+or base64, alongside an error.
+*/
+func decodeDER(in string) (der []byte, err error) {
+	h := strings.NewReplacer(`:`, ``, ` `, ``, "\t", ``).Replace(in)
+	if len(h) > 2 && (h[:2] == `0x` || h[:2] == `0X`) {
+		h = h[2:]
+	}
+
+	if der, err = hex.DecodeString(h); err == nil {
+		return
+	}
+
+	if der, err = base64.StdEncoding.DecodeString(in); err == nil {
+		return
+	}
+
+	if der, err = base64.RawStdEncoding.DecodeString(in); err != nil {
+		err = errors.New(`not a dot notation, ASN.1 notation, hex or base64 value`)
+	}
+
+	return
+}
+
+/*
+forms contains the output of the convert, encode and decode commands.
+*/
+type forms struct {
+	Input  string `json:"input"`
+	Dot    string `json:"dot,omitempty"`
+	ASN    string `json:"asn,omitempty"`
+	Hex    string `json:"hex,omitempty"`
+	Base64 string `json:"base64,omitempty"`
+
+	textForm string
+}
+
+func (r forms) text() string { return r.textForm }
+func (r forms) ok() bool     { return true }
+
+func convert(c *config, in string) (res result, err error) {
+	var v value
+	if v, err = c.parse(in); err != nil {
+		return
+	}
+
+	var der []byte
+	if der, err = v.dot.Encode(); err != nil {
+		return
+	}
+
+	f := forms{
+		Input:  in,
+		Dot:    v.dot.String(),
+		ASN:    c.asn(v),
+		Hex:    hex.EncodeToString(der),
+		Base64: base64.StdEncoding.EncodeToString(der),
+	}
+	f.textForm = fmt.Sprintf("dot:    %s\nasn:    %s\nhex:    %s\nbase64: %s", f.Dot, f.ASN, f.Hex, f.Base64)
+	res = f
+
+	return
+}
+
+func encode(c *config, in string) (res result, err error) {
+	var v value
+	if v, err = c.parse(in); err != nil {
+		return
+	}
+
+	var der []byte
+	if der, err = v.dot.Encode(); err != nil {
+		return
+	}
+
+	f := forms{Input: in, Dot: v.dot.String()}
+	if c.base64 {
+		f.Base64 = base64.StdEncoding.EncodeToString(der)
+		f.textForm = f.Base64
+	} else {
+		f.Hex = hex.EncodeToString(der)
+		f.textForm = f.Hex
+	}
+	res = f
+
+	return
+}
+
+func decode(c *config, in string) (res result, err error) {
+	var v value
+	if v, err = c.decode(in); err == nil {
+		res = forms{Input: in, Dot: v.dot.String(), ASN: c.asn(v), textForm: v.dot.String()}
+	}
+
+	return
+}
+
+/*
+validity contains the output of the validate command.
+*/
+type validity struct {
+	Input  string `json:"input"`
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r validity) ok() bool { return r.Valid }
+
+func (r validity) text() string {
+	if r.Valid {
+		return r.Input + `: valid`
+	}
+
+	return r.Input + `: invalid: ` + r.Reason
+}
+
+func validate(c *config, in string) (result, error) {
+	res := validity{Input: in}
+
+	v, err := c.parse(in)
+	switch {
+	case err != nil:
+		res.Reason = err.Error()
+	case !v.dot.Valid():
+		res.Reason = `not a valid OID`
+	default:
+		res.Valid = true
+	}
+
+	return res, nil
+}
+
+/*
+lineage contains the output of the ancestry command.
+*/
+type lineage struct {
+	Input    string     `json:"input"`
+	Ancestry []ancestor `json:"ancestry"`
+}
+
+type ancestor struct {
+	Dot string `json:"dot"`
+	ASN string `json:"asn"`
+}
+
+func (r lineage) ok() bool { return true }
+
+func (r lineage) text() string {
+	lines := make([]string, len(r.Ancestry))
+	for i, a := range r.Ancestry {
+		lines[i] = a.Dot + "\t" + a.ASN
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func ancestry(c *config, in string) (res result, err error) {
+	var v value
+	if v, err = c.parse(in); err != nil {
+		return
+	}
+
+	l := lineage{Input: in}
+	for _, anc := range v.dot.Ancestry() {
+		a := value{dot: anc}
+		if len(anc) == len(v.dot) {
+			a.asn = v.asn
+		}
+		l.Ancestry = append(l.Ancestry, ancestor{Dot: anc.String(), ASN: c.asn(a)})
+	}
+	res = l
+
+	return
+}
+
+/*
+relation contains the output of the relate command.
+*/
+type relation struct {
+	Reference  string `json:"reference"`
+	Input      string `json:"input"`
+	AncestorOf bool   `json:"ancestorOf"`
+	ChildOf    bool   `json:"childOf"`
+	SiblingOf  bool   `json:"siblingOf"`
+}
+
+func (r relation) ok() bool { return true }
+
+func (r relation) text() string {
+	return fmt.Sprintf("%s: AncestorOf=%t ChildOf=%t SiblingOf=%t",
+		r.Input, r.AncestorOf, r.ChildOf, r.SiblingOf)
+}
+
+/*
+relate returns a handler which compares each value with ref.
+*/
+func relate(ref value) func(*config, string) (result, error) {
+	return func(c *config, in string) (res result, err error) {
+		var v value
+		if v, err = c.parse(in); err == nil {
+			res = relation{
+				Reference:  ref.dot.String(),
+				Input:      in,
+				AncestorOf: ref.dot.AncestorOf(v.dot),
+				ChildOf:    ref.dot.ChildOf(v.dot),
+				SiblingOf:  ref.dot.SiblingOf(v.dot),
+			}
+		}
+
+		return
+	}
+}
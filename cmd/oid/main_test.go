@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func testRun(stdin string, args ...string) (stdout, stderr string, status int) {
+	var o, e bytes.Buffer
+	status = run(args, strings.NewReader(stdin), &o, &e)
+	return o.String(), e.String(), status
+}
+
+func TestRun(t *testing.T) {
+	for idx, tc := range []struct {
+		args   []string
+		stdin  string
+		stdout string
+		status int
+	}{
+		{
+			args: []string{`convert`, `{iso identified-organization dod internet}`},
+			stdout: "dot:    1.3.6.1\n" +
+				"asn:    {iso(1) identified-organization(3) dod(6) internet(1)}\n" +
+				"hex:    06032b0601\n" +
+				"base64: BgMrBgE=\n",
+		},
+		{
+			args:   []string{`convert`, `-json`, `BgMrBgE=`},
+			stdout: `{"input":"BgMrBgE=","dot":"1.3.6.1","asn":"{iso(1) identified-organization(3) dod(6) internet(1)}","hex":"06032b0601","base64":"BgMrBgE="}` + "\n",
+		},
+		{
+			args:   []string{`encode`},
+			stdin:  "1.3.6.1.4.1.56521\n\n{iso(1) identified-organization(3) 6}\n",
+			stdout: "06082b0601040183b949\n06022b06\n",
+		},
+		{
+			args:   []string{`encode`, `-base64`, `1.3.6.1`},
+			stdout: "BgMrBgE=\n",
+		},
+		{
+			args:   []string{`decode`, `0x06032B0601`, `06:03:2b:06:01`, `BgMrBgE`},
+			stdout: "1.3.6.1\n1.3.6.1\n1.3.6.1\n",
+		},
+		{
+			args:   []string{`decode`, `-strict`, `-json`, `06042b068001`},
+			stdout: `{"input":"06042b068001","error":"Non-minimal subidentifier encoding (leading 0x80 octet) (offset 4)"}` + "\n",
+			status: 1,
+		},
+		{
+			args:   []string{`validate`, `1.3.6.1`, `3.1`, `1.3.06`},
+			stdout: "1.3.6.1: valid\n3.1: invalid: Root arc must be 0, 1 or 2: '3.1' (arc 0, offset 0)\n1.3.06: invalid: Non-canonical NumberForm: leading zeros are not permitted: '06' in '1.3.06' (arc 2, offset 4)\n",
+			status: 1,
+		},
+		{
+			args:   []string{`ancestry`, `2.25.1`},
+			stdout: "2.25.1\t{joint-iso-itu-t(2) uuid(25) 1}\n2.25\t{joint-iso-itu-t(2) uuid(25)}\n2\t{joint-iso-itu-t(2)}\n",
+		},
+		{
+			args: []string{`relate`, `-json`, `1.3.6`, `1.3.6.1`, `1.3.7`},
+			stdout: `{"reference":"1.3.6","input":"1.3.6.1","ancestorOf":true,"childOf":true,"siblingOf":false}` + "\n" +
+				`{"reference":"1.3.6","input":"1.3.7","ancestorOf":false,"childOf":false,"siblingOf":true}` + "\n",
+		},
+		{
+			args:   []string{`relate`, `1.3`},
+			stdin:  "1.3.6.1\n",
+			stdout: "1.3.6.1: AncestorOf=true ChildOf=false SiblingOf=false\n",
+		},
+	} {
+		stdout, stderr, status := testRun(tc.stdin, tc.args...)
+		if stdout != tc.stdout || status != tc.status {
+			t.Errorf("%s[%d] failed:\nwant (%d): %q\ngot  (%d): %q\nstderr: %s",
+				t.Name(), idx, tc.status, tc.stdout, status, stdout, stderr)
+		}
+	}
+}
+
+func TestRun_usage(t *testing.T) {
+	for idx, tc := range []struct {
+		args   []string
+		status int
+	}{
+		{nil, 2},
+		{[]string{`help`}, 0},
+		{[]string{`bogus`}, 2},
+		{[]string{`relate`}, 2},
+		{[]string{`encode`, `-bogus`}, 2},
+		{[]string{`decode`, `-base64`}, 2},
+		{[]string{`relate`, `bogus`, `1.3`}, 1},
+		{[]string{`encode`, `bogus`}, 1},
+	} {
+		if _, _, status := testRun(``, tc.args...); status != tc.status {
+			t.Errorf("%s[%d] failed: want status %d, got %d", t.Name(), idx, tc.status, status)
+		}
+	}
+}
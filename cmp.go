@@ -0,0 +1,165 @@
+package objectid
+
+/*
+cmp.go provides total ordering and equality functions for the notation
+types within this package.
+*/
+
+import (
+	"math/big"
+	"slices"
+)
+
+/*
+Compare returns an integer comparing two (2) instances of [DotNotation]
+arc-wise, such that:
+
+  - -1 is returned if a sorts before b
+  - 0 is returned if a and b are equal
+  - +1 is returned if a sorts after b
+
+Arcs are compared numerically, in order, and an ancestor always sorts
+before its descendants. For example, "1.3.6" sorts before "1.3.6.1",
+which sorts before "1.3.7" and "1.3.10".
+
+This function is suitable for use with [slices.SortFunc] and similar.
+*/
+func Compare(a, b DotNotation) int {
+	for i := 0; i < a.Len() && i < b.Len(); i++ {
+		if c := a[i].cmp(b[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case a.Len() < b.Len():
+		return -1
+	case a.Len() > b.Len():
+		return 1
+	}
+
+	return 0
+}
+
+/*
+CompareASN1 returns an integer comparing two (2) instances of [ASN1Notation]
+in the same manner as [Compare]. Where the [NumberForm] values of a and b
+are identical, the identifiers of each arc are compared lexically, in order,
+thereby providing a total ordering consistent with [ASN1Notation.Equal].
+*/
+func CompareASN1(a, b ASN1Notation) int {
+	if c := Compare(a.numberForms(), b.numberForms()); c != 0 {
+		return c
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		switch x, y := a[i].identifier, b[i].identifier; {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+/*
+CompareOID returns an integer comparing two (2) instances of [OID] in the
+same manner as [CompareASN1].
+*/
+func CompareOID(a, b OID) int {
+	return CompareASN1(a.nanf, b.nanf)
+}
+
+/*
+Compare returns an integer comparing the receiver to the input value,
+which can be a string, [DotNotation] or *[DotNotation]. See the [Compare]
+function for details. An unusable input value is treated as zero.
+*/
+func (r DotNotation) Compare(dot any) int {
+	var D DotNotation
+	if d := assertDotNot(dot); d != nil {
+		D = *d
+	}
+
+	return Compare(r, D)
+}
+
+/*
+Equal returns a Boolean value indicative of whether the receiver and the
+input value, which can be a string, [DotNotation] or *[DotNotation], are
+equal. Zero instances are never equal.
+*/
+func (r DotNotation) Equal(dot any) bool {
+	D := assertDotNot(dot)
+	return !r.IsZero() && D != nil && !D.IsZero() && Compare(r, *D) == 0
+}
+
+/*
+Compare returns an integer comparing the receiver to the input value,
+which can be a string, [ASN1Notation] or *[ASN1Notation]. See the
+[CompareASN1] function for details. An unusable input value is treated
+as zero.
+*/
+func (r ASN1Notation) Compare(asn any) int {
+	var A ASN1Notation
+	if a := assertASN1Notation(asn); a != nil {
+		A = *a
+	}
+
+	return CompareASN1(r, A)
+}
+
+/*
+Equal returns a Boolean value indicative of whether the receiver and the
+input value, which can be a string, [ASN1Notation] or *[ASN1Notation], are
+equal in terms of both identifiers and [NumberForm] values. Zero instances
+are never equal.
+*/
+func (r ASN1Notation) Equal(asn any) bool {
+	A := assertASN1Notation(asn)
+	return !r.IsZero() && A != nil && !A.IsZero() && CompareASN1(r, *A) == 0
+}
+
+/*
+SortDotNotations sorts the input slices of [DotNotation] in place, in the
+order defined by the [Compare] function.
+*/
+func SortDotNotations(dots []DotNotation) {
+	slices.SortStableFunc(dots, Compare)
+}
+
+/*
+SortOIDs sorts the input slices of [OID] in place, in the order defined by
+the [CompareOID] function.
+*/
+func SortOIDs(ids []OID) {
+	slices.SortStableFunc(ids, CompareOID)
+}
+
+/*
+numberForms returns the [NumberForm] values of the receiver as a
+[DotNotation], regardless of length.
+*/
+func (r ASN1Notation) numberForms() (d DotNotation) {
+	d = make(DotNotation, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		d[i] = r[i].primaryIdentifier
+	}
+	return
+}
+
+/*
+cmp returns -1, 0 or +1 following a numerical comparison of the receiver
+and n.
+*/
+func (r NumberForm) cmp(n NumberForm) int {
+	if x, ok := r.small(); ok {
+		if y, ok := n.small(); ok {
+			return cmpUint64(x, y)
+		}
+	}
+
+	return (*big.Int)(&r).Cmp((*big.Int)(&n))
+}
@@ -0,0 +1,97 @@
+package objectid
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleCompare() {
+	var dots []DotNotation
+	for _, raw := range []string{
+		`1.3.10`,
+		`1.3.6.1`,
+		`2.25.987895962269883002155146617097157934`,
+		`1.3.6`,
+		`1.3.7`,
+		`0.9`,
+	} {
+		dot, _ := NewDotNotation(raw)
+		dots = append(dots, *dot)
+	}
+
+	slices.SortFunc(dots, Compare)
+	fmt.Println(dots)
+	// Output: [0.9 1.3.6 1.3.6.1 1.3.7 1.3.10 2.25.987895962269883002155146617097157934]
+}
+
+func ExampleDotNotation_Equal() {
+	dot, _ := NewDotNotation(`1.3.6.1`)
+	fmt.Printf("%t", dot.Equal(`1.3.6.1`))
+	// Output: true
+}
+
+func TestCompare(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{`1.3.6`, `1.3.6`, 0},
+		{`1.3.6`, `1.3.6.1`, -1},
+		{`1.3.6.1`, `1.3.6`, 1},
+		{`1.3.7`, `1.3.6.1`, 1},
+		{`1.3.10`, `1.3.9`, 1},
+		{`2.25.987895962269883002155146617097157934`, `2.25.987895962269883002155146617097157933`, 1},
+		{`1.3`, ``, 1},
+	} {
+		a := assertDotNot(tc.a)
+		if got := a.Compare(tc.b); got != tc.want {
+			t.Errorf("%s[%d] failed: want %d, got %d", t.Name(), idx, tc.want, got)
+		}
+		if got := a.Equal(tc.b); got != (tc.want == 0) {
+			t.Errorf("%s[%d] failed: unexpected equality result %t", t.Name(), idx, got)
+		}
+	}
+
+	var zero DotNotation
+	if zero.Equal(zero) {
+		t.Errorf("%s failed: zero instances considered equal", t.Name())
+	}
+}
+
+func TestCompareASN1(t *testing.T) {
+	for idx, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{`{iso(1) identified-organization(3)}`, `{iso(1) identified-organization(3)}`, 0},
+		{`{iso(1) identified-organization(3)}`, `{iso(1) 3}`, 1},
+		{`{iso(1) 3}`, `{iso(1) identified-organization(3) dod(6)}`, -1},
+		{`{iso(1) b(3)}`, `{iso(1) a(4)}`, -1},
+		{`{iso(1) 3}`, `{bogus`, 1},
+	} {
+		a, _ := NewASN1Notation(tc.a)
+		if got := a.Compare(tc.b); got != tc.want {
+			t.Errorf("%s[%d] failed: want %d, got %d", t.Name(), idx, tc.want, got)
+		}
+		if got := a.Equal(tc.b); got != (tc.want == 0) {
+			t.Errorf("%s[%d] failed: unexpected equality result %t", t.Name(), idx, got)
+		}
+	}
+
+	var ids []OID
+	for _, raw := range []string{`{iso(1) 3 6}`, `{itu-t(0) 9}`, `{iso(1) 3}`} {
+		id, _ := NewOID(raw)
+		ids = append(ids, *id)
+	}
+	SortOIDs(ids)
+	if got := fmt.Sprint(ids); got != `[{itu-t(0) 9} {iso(1) 3} {iso(1) 3 6}]` {
+		t.Errorf("%s failed: unexpected order %s", t.Name(), got)
+	}
+
+	dots := []DotNotation{ids[2].Dot(), ids[0].Dot(), ids[1].Dot()}
+	SortDotNotations(dots)
+	if got := fmt.Sprint(dots); got != `[0.9 1.3 1.3.6]` {
+		t.Errorf("%s failed: unexpected order %s", t.Name(), got)
+	}
+}
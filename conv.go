@@ -0,0 +1,122 @@
+package objectid
+
+/*
+conv.go handles conversion to and from the OID types found within
+the encoding/asn1 and crypto/x509 packages.
+*/
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+)
+
+/*
+ToASN1 returns an instance of [encoding/asn1.ObjectIdentifier] based upon
+the receiver instance, alongside an error.
+
+An error is returned if the receiver is invalid, or if any single arc is
+too large to be represented as an int, as [encoding/asn1.ObjectIdentifier]
+is not unbounded. In such a case, [DotNotation.ToX509OID] should be used.
+*/
+func (r DotNotation) ToASN1() (oid asn1.ObjectIdentifier, err error) {
+	if !r.Valid() {
+		err = errorf("Invalid %T '%s' cannot be converted", r, r)
+		return
+	}
+
+	maxInt := uint64(^uint(0) >> 1)
+
+	_oid := make(asn1.ObjectIdentifier, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		n := r[i].cast()
+		if !n.IsUint64() || n.Uint64() > maxInt {
+			err = errorf("Arc %d (%s) overflows int; cannot be represented as %T", i, r[i], oid)
+			return
+		}
+		_oid[i] = int(n.Uint64())
+	}
+	oid = _oid
+
+	return
+}
+
+/*
+ToX509OID returns an instance of [crypto/x509.OID] based upon the receiver
+instance, alongside an error.
+
+Conversion is performed by way of the receiver's ASN.1 encoding, and is
+therefore lossless regardless of arc magnitude.
+*/
+func (r DotNotation) ToX509OID() (oid x509.OID, err error) {
+	var b []byte
+	if b, err = r.encodeContent(); err == nil {
+		err = oid.UnmarshalBinary(b)
+	}
+
+	return
+}
+
+/*
+ToASN1 returns an instance of [encoding/asn1.ObjectIdentifier] based upon
+the receiver instance, alongside an error. See [DotNotation.ToASN1] for
+details.
+*/
+func (r OID) ToASN1() (asn1.ObjectIdentifier, error) {
+	return r.Dot().ToASN1()
+}
+
+/*
+ToX509OID returns an instance of [crypto/x509.OID] based upon the receiver
+instance, alongside an error. See [DotNotation.ToX509OID] for details.
+*/
+func (r OID) ToX509OID() (x509.OID, error) {
+	return r.Dot().ToX509OID()
+}
+
+/*
+FromASN1 returns an instance of *[DotNotation] alongside an error following
+an attempt to convert the input [encoding/asn1.ObjectIdentifier] instance.
+The root and second arcs are subject to the same constraints as those of
+[NewDotNotation], and any error returned is a *[ParseError].
+*/
+func FromASN1(oid asn1.ObjectIdentifier) (r *DotNotation, err error) {
+	x := make([]any, len(oid))
+	for i := 0; i < len(oid); i++ {
+		x[i] = oid[i]
+	}
+
+	// apply the root and second arc constraints
+	// imposed upon string input by NewDotNotation.
+	var D *DotNotation
+	if D, err = NewDotNotation(x...); err == nil {
+		if err = D.validPrefix(oid.String()); err == nil {
+			r = D
+		}
+	}
+
+	return
+}
+
+/*
+FromX509OID returns an instance of *[DotNotation] alongside an error
+following an attempt to convert the input [crypto/x509.OID] instance.
+
+Conversion is performed by way of the input value's ASN.1 encoding, and
+is therefore lossless regardless of arc magnitude.
+*/
+func FromX509OID(oid x509.OID) (r *DotNotation, err error) {
+	var b []byte
+	if b, err = oid.MarshalBinary(); err != nil {
+		return
+	} else if len(b) == 0 {
+		err = errorf("Zero %T instance cannot be converted", oid)
+		return
+	}
+
+	var D DotNotation
+	if D, err = decodeSubidentifiers(b, 0, true, true); err == nil {
+		r = &D
+	}
+
+	return
+}
@@ -0,0 +1,105 @@
+package objectid
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_ToASN1() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	oid, err := dot.ToASN1()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%T: %s", oid, oid)
+	// Output: asn1.ObjectIdentifier: 1.3.6.1.4.1.56521
+}
+
+func ExampleDotNotation_ToX509OID() {
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	oid, err := dot.ToX509OID()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%T: %s", oid, oid)
+	// Output: x509.OID: 2.25.987895962269883002155146617097157934
+}
+
+func ExampleFromX509OID() {
+	oid, _ := x509.ParseOID(`2.25.987895962269883002155146617097157934`)
+	dot, err := FromX509OID(oid)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", dot)
+	// Output: 2.25.987895962269883002155146617097157934
+}
+
+func TestConversions(t *testing.T) {
+	id, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521}`)
+
+	a, err := id.ToASN1()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var x x509.OID
+	if x, err = id.ToX509OID(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if !x.EqualASN1OID(a) {
+		t.Errorf("%s failed: %s != %s", t.Name(), x, a)
+		return
+	}
+
+	var D *DotNotation
+	if D, err = FromASN1(a); err != nil || D.String() != a.String() {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	// huge arcs cannot be held by asn1.ObjectIdentifier
+	dot, _ := NewDotNotation(`2.25.987895962269883002155146617097157934`)
+	if _, err = dot.ToASN1(); err == nil {
+		t.Errorf("%s failed: expected overflow error, got nothing", t.Name())
+	}
+
+	for _, bogus := range []struct {
+		oid  asn1.ObjectIdentifier
+		kind error
+	}{
+		{asn1.ObjectIdentifier{1}, ErrTooFewArcs},
+		{asn1.ObjectIdentifier{1, -3}, ErrNegative},
+		{asn1.ObjectIdentifier{3, 1}, ErrInvalidRoot},
+		{asn1.ObjectIdentifier{1, 50}, ErrInvalidSecondArc},
+	} {
+		if _, err = FromASN1(bogus.oid); !errors.Is(err, bogus.kind) {
+			t.Errorf("%s failed: %v: want %v, got %v", t.Name(), bogus.oid, bogus.kind, err)
+		}
+	}
+
+	if D, err = FromASN1(asn1.ObjectIdentifier{2, 50}); err != nil || D.String() != `2.50` {
+		t.Errorf("%s failed: 2.50 not converted: %v", t.Name(), err)
+	}
+
+	if _, err = FromX509OID(x509.OID{}); err == nil {
+		t.Errorf("%s failed: zero %T converted without error", t.Name(), x)
+	}
+
+	var zero DotNotation
+	if _, err = zero.ToASN1(); err == nil {
+		t.Errorf("%s failed: zero %T converted without error", t.Name(), zero)
+	}
+	if _, err = zero.ToX509OID(); err == nil {
+		t.Errorf("%s failed: zero %T converted without error", t.Name(), zero)
+	}
+}
@@ -0,0 +1,436 @@
+package objectid
+
+/*
+decoder.go implements a streaming decoder of ASN.1 encoded OIDs.
+*/
+
+import (
+	"bufio"
+	"io"
+	"math/big"
+	"slices"
+)
+
+/*
+Decoder reads successive ASN.1 OBJECT IDENTIFIER encodings (TLVs) from
+a byte slice or an [io.Reader]. Instances of this type should only be
+initialized using the [NewDecoder] or [NewReaderDecoder] functions.
+
+Unlike [DotNotation.Decode], a Decoder reuses its storage from one OID to
+the next, and exposes arcs as uint64 values where they fit. Once warmed
+up, decoding such OIDs does not allocate. Arcs of greater magnitude, such
+as those beneath joint-iso-itu-t(2) uuid(25), are available by way of the
+[Decoder.DotNotation] method.
+
+Typical usage resembles that of [bufio.Scanner]:
+
+	dec := objectid.NewDecoder(b)
+	for dec.Next() {
+		if arcs, ok := dec.Uint64s(); ok {
+			// use arcs
+		}
+	}
+	if err := dec.Err(); err != nil {
+		// handle error
+	}
+
+A Decoder is not safe for concurrent use.
+*/
+type Decoder struct {
+	src     []byte        // byte slice source, if not rd
+	rd      *bufio.Reader // reader source, if not src
+	buf     []byte        // content storage for reader sources
+	opts    DecodeOption
+	off     int      // stream offset of the next TLV
+	start   int      // stream offset of the current TLV
+	content []byte   // content octets of the current TLV
+	arcs    []uint64 // arcs of the current TLV
+	fits    bool     // whether all arcs fit within a uint64
+	tmp     big.Int
+	err     error
+}
+
+/*
+decoderChunk is the maximum number of content octets read from an
+[io.Reader] at once, such that a spurious length cannot provoke an
+allocation larger than the data actually available.
+*/
+const decoderChunk = 4096
+
+/*
+NewDecoder returns a freshly initialized *[Decoder] which reads OIDs from
+b. Optional [DecodeOption] values are honored as they would be by the
+[DotNotation.Decode] method.
+*/
+func NewDecoder(b []byte, opts ...DecodeOption) *Decoder {
+	r := &Decoder{opts: decodeOptions(opts)}
+	r.Reset(b)
+
+	return r
+}
+
+/*
+NewReaderDecoder returns a freshly initialized *[Decoder] which reads OIDs
+from rd. Optional [DecodeOption] values are honored as they would be by
+the [DotNotation.Decode] method.
+
+The reader is buffered internally, thus rd may be read beyond the last
+OID returned.
+*/
+func NewReaderDecoder(rd io.Reader, opts ...DecodeOption) *Decoder {
+	r := &Decoder{opts: decodeOptions(opts)}
+	r.ResetReader(rd)
+
+	return r
+}
+
+/*
+Reset discards the state of the receiver, which shall read subsequent
+OIDs from b. Storage is retained for reuse.
+*/
+func (r *Decoder) Reset(b []byte) {
+	r.reset()
+	r.src, r.rd = b, nil
+}
+
+/*
+ResetReader discards the state of the receiver, which shall read
+subsequent OIDs from rd. Storage is retained for reuse.
+*/
+func (r *Decoder) ResetReader(rd io.Reader) {
+	r.reset()
+	if r.rd != nil {
+		r.rd.Reset(rd)
+	} else {
+		r.rd = bufio.NewReader(rd)
+	}
+	r.src = nil
+}
+
+func (r *Decoder) reset() {
+	r.off, r.start, r.err = 0, 0, nil
+	r.content, r.arcs, r.fits = nil, r.arcs[:0], false
+}
+
+/*
+Buffer sets the initial storage used for the arcs returned by the
+[Decoder.Uint64s] method. It should be called before the first call to
+[Decoder.Next].
+*/
+func (r *Decoder) Buffer(arcs []uint64) {
+	r.arcs = arcs[:0]
+}
+
+/*
+Next returns a Boolean value indicative of whether another OID was read
+and verified. A false return indicates that the input was exhausted, or
+that an error occurred, in which case [Decoder.Err] shall return it.
+*/
+func (r *Decoder) Next() bool {
+	if r.err != nil {
+		return false
+	}
+
+	r.content, r.arcs, r.fits = nil, r.arcs[:0], false
+
+	var off int // stream offset of the content octets
+	if r.rd != nil {
+		off, r.err = r.readTLV()
+	} else {
+		off, r.err = r.sliceTLV()
+	}
+
+	if r.err == nil {
+		r.arcs, r.fits, r.err = decodeUint64s(r.arcs, r.content, off, r.opts&MinimalSubidentifiers != 0)
+	}
+
+	return r.err == nil
+}
+
+/*
+Err returns the first error encountered by the receiver, if any. The end
+of the input is not considered an error.
+
+Decoding failures are returned as a *[DecodeError], the offset of which
+is relative to the beginning of the input. Errors produced by an
+underlying [io.Reader] are returned as is.
+*/
+func (r *Decoder) Err() error {
+	if r.err == io.EOF {
+		return nil
+	}
+
+	return r.err
+}
+
+/*
+Offset returns the offset of the current OID's encoding relative to the
+beginning of the input.
+*/
+func (r *Decoder) Offset() int {
+	return r.start
+}
+
+/*
+Uint64s returns the arcs of the current OID alongside a Boolean value
+indicative of whether all arcs fit within a uint64. If false, the arcs
+must be obtained using the [Decoder.DotNotation] method instead.
+
+The returned slice is only valid until the next call to [Decoder.Next].
+*/
+func (r *Decoder) Uint64s() ([]uint64, bool) {
+	if !r.fits {
+		return nil, false
+	}
+
+	return r.arcs, true
+}
+
+/*
+DotNotation returns the current OID as a [DotNotation], reusing the storage
+of dst -- including that of its arcs -- where possible. Any [NumberForm]
+values within the capacity of dst are overwritten, and so must not be shared
+with other instances. Supply nil to obtain freshly allocated storage.
+*/
+func (r *Decoder) DotNotation(dst DotNotation) DotNotation {
+	dst = dst[:0]
+
+	if r.fits {
+		for _, v := range r.arcs {
+			var x *big.Int
+			dst, x = extendDot(dst)
+			x.SetUint64(v)
+		}
+		return dst
+	}
+
+	// the content octets were verified by Next,
+	// thus no error conditions may arise here.
+	var (
+		x     *big.Int
+		v     uint64
+		huge  bool
+		start bool = true
+	)
+
+	for i, c := range r.content {
+		if start && i == 0 {
+			dst, _ = extendDot(dst) // first arc, set below
+		}
+		if start {
+			dst, x = extendDot(dst)
+			v, huge = 0, false
+		}
+
+		if !huge && v>>57 != 0 {
+			x.SetUint64(v)
+			huge = true
+		}
+
+		if huge {
+			x.Lsh(x, 7)
+			x.Or(x, r.tmp.SetUint64(uint64(c&0x7F)))
+		} else {
+			v = v<<7 | uint64(c&0x7F)
+		}
+
+		if start = c&0x80 == 0; start {
+			if !huge {
+				x.SetUint64(v)
+			}
+
+			if len(dst) == 2 {
+				r.splitFirst(dst, v, huge)
+			}
+		}
+	}
+
+	return dst
+}
+
+/*
+splitFirst derives the first two (2) arcs of dst from the first
+subidentifier, held within dst[1].
+*/
+func (r *Decoder) splitFirst(dst DotNotation, v uint64, huge bool) {
+	first, second := (*big.Int)(&dst[0]), (*big.Int)(&dst[1])
+	if huge {
+		first.SetUint64(2)
+		second.Sub(second, r.tmp.SetUint64(80))
+		return
+	}
+
+	x, y := splitFirstArcs(v)
+	first.SetUint64(x)
+	second.SetUint64(y)
+}
+
+/*
+extendDot extends d by one (1) arc, reusing existing storage if
+possible, and returns the extended slice alongside the new arc.
+*/
+func extendDot(d DotNotation) (DotNotation, *big.Int) {
+	if len(d) < cap(d) {
+		d = d[:len(d)+1]
+	} else {
+		d = append(d, NumberForm{})
+	}
+
+	return d, (*big.Int)(&d[len(d)-1])
+}
+
+/*
+sliceTLV reads the next TLV from the receiver's byte slice, returning
+the stream offset of its content octets alongside an error.
+*/
+func (r *Decoder) sliceTLV() (off int, err error) {
+	b := r.src[r.off:]
+	if len(b) == 0 {
+		err = io.EOF
+		return
+	} else if b[0] != 0x06 {
+		err = decodeErr(ErrInvalidTag, r.off)
+		return
+	}
+
+	var length, n int
+	if length, n, err = decodeLength(b[1:], r.off+1, r.opts&DERLength != 0); err != nil {
+		return
+	}
+
+	switch hdr := 1 + n; {
+	case length == 0:
+		err = decodeErr(ErrTruncated, r.off+hdr)
+	case length > len(b)-hdr:
+		err = decodeErr(ErrTruncated, len(r.src))
+	default:
+		r.content = b[hdr : hdr+length]
+		r.start, off = r.off, r.off+hdr
+		r.off += hdr + length
+	}
+
+	return
+}
+
+/*
+readTLV reads the next TLV from the receiver's reader, returning the
+stream offset of its content octets alongside an error.
+*/
+func (r *Decoder) readTLV() (off int, err error) {
+	var tag byte
+	if tag, err = r.rd.ReadByte(); err != nil {
+		return // io.EOF denotes a clean end
+	} else if tag != 0x06 {
+		err = decodeErr(ErrInvalidTag, r.off)
+		return
+	}
+
+	// one (1) initial octet, followed by as
+	// many as 127 subsequent length octets.
+	var hdr [128]byte
+	if hdr[0], err = r.rd.ReadByte(); err != nil {
+		err = r.readErr(err, 1)
+		return
+	}
+
+	var n int
+	if hdr[0] > 0x80 && hdr[0] != 0xFF {
+		for octets := int(hdr[0] & 0x7F); n < octets; n++ {
+			if hdr[1+n], err = r.rd.ReadByte(); err != nil {
+				err = r.readErr(err, 2+n)
+				return
+			}
+		}
+	}
+
+	var length int
+	if length, n, err = decodeLength(hdr[:1+n], r.off+1, r.opts&DERLength != 0); err != nil {
+		return
+	}
+	n++ // include tag
+
+	if length == 0 {
+		err = decodeErr(ErrTruncated, r.off+n)
+		return
+	}
+
+	// grow the buffer only as data arrives.
+	r.buf = r.buf[:0]
+	for len(r.buf) < length {
+		chunk := length - len(r.buf)
+		if chunk > decoderChunk {
+			chunk = decoderChunk
+		}
+		r.buf = slices.Grow(r.buf, chunk)
+
+		var got int
+		got, err = io.ReadFull(r.rd, r.buf[len(r.buf):len(r.buf)+chunk])
+		r.buf = r.buf[:len(r.buf)+got]
+		if err != nil {
+			err = r.readErr(err, n+len(r.buf))
+			return
+		}
+	}
+
+	r.content = r.buf
+	r.start, off = r.off, r.off+n
+	r.off += n + length
+
+	return
+}
+
+/*
+readErr returns err, converted to a *[DecodeError] bearing [ErrTruncated]
+if the reader was exhausted at the given offset within the current TLV.
+*/
+func (r *Decoder) readErr(err error, at int) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = decodeErr(ErrTruncated, r.off+at)
+	}
+
+	return err
+}
+
+/*
+decodeUint64s appends the arcs encoded within the content octets b to dst,
+returning the extended slice alongside a Boolean value indicative of whether
+all arcs fit within a uint64, and an error. The off and minimal values are
+as described for decodeSubidentifiers. All content octets are verified, even
+if an arc does not fit; the first subidentifier must fit for the first two
+(2) arcs to be considered as fitting.
+*/
+func decodeUint64s(dst []uint64, b []byte, off int, minimal bool) (arcs []uint64, fits bool, err error) {
+	arcs, fits = dst, true
+
+	var v uint64
+	start, first := true, true
+	for i := 0; i < len(b); i++ {
+		if start && b[i] == 0x80 && minimal {
+			err = decodeErr(ErrNonMinimalSubidentifier, off+i)
+			return
+		}
+
+		if v>>57 != 0 {
+			fits = false
+		}
+		v = v<<7 | uint64(b[i]&0x7F)
+
+		if start = b[i]&0x80 == 0; start {
+			if !fits {
+				arcs = arcs[:len(dst)]
+			} else if first {
+				x, y := splitFirstArcs(v)
+				arcs = append(arcs, x, y)
+			} else {
+				arcs = append(arcs, v)
+			}
+			v, first = 0, false
+		}
+	}
+
+	if !start {
+		err = decodeErr(ErrTruncatedSubidentifier, off+len(b)-1)
+	}
+
+	return
+}
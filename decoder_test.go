@@ -0,0 +1,250 @@
+package objectid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"testing"
+	"testing/iotest"
+)
+
+func ExampleDecoder() {
+	// rsaEncryption, sha256WithRSAEncryption and
+	// id-ecPublicKey, encoded back to back.
+	b := []byte{
+		0x06, 0x09, 0x2A, 0x86, 0x48, 0x86, 0xF7, 0x0D, 0x01, 0x01, 0x01,
+		0x06, 0x09, 0x2A, 0x86, 0x48, 0x86, 0xF7, 0x0D, 0x01, 0x01, 0x0B,
+		0x06, 0x07, 0x2A, 0x86, 0x48, 0xCE, 0x3D, 0x02, 0x01,
+	}
+
+	dec := NewDecoder(b)
+	for dec.Next() {
+		arcs, ok := dec.Uint64s()
+		fmt.Println(dec.Offset(), arcs, ok)
+	}
+	fmt.Println(dec.Err())
+	// Output:
+	// 0 [1 2 840 113549 1 1 1] true
+	// 11 [1 2 840 113549 1 1 11] true
+	// 22 [1 2 840 10045 2 1] true
+	// <nil>
+}
+
+func ExampleDecoder_DotNotation() {
+	d, _ := NewDotNotation(`2.25.329800735698586629295641978511506172918`)
+	b, _ := d.Encode()
+
+	dec := NewReaderDecoder(bytes.NewReader(b))
+	for dec.Next() {
+		_, ok := dec.Uint64s()
+		fmt.Println(dec.DotNotation(nil), ok)
+	}
+	// Output: 2.25.329800735698586629295641978511506172918 false
+}
+
+func TestDecoder(t *testing.T) {
+	var stream []byte
+	var want []string
+	for _, dot := range []string{
+		`0.0`,
+		`1.3.6.1.4.1.56521`,
+		`2.999.1`,
+		`2.25.329800735698586629295641978511506172918.7`,
+		`2.18446744073709551616`,
+		`1.39.18446744073709551615`,
+		`2.18446744073709551535`,
+	} {
+		d, _ := NewDotNotation(dot)
+		b, _ := d.Encode()
+		stream = append(stream, b...)
+		want = append(want, dot)
+	}
+
+	for name, dec := range map[string]*Decoder{
+		`bytes`:  NewDecoder(stream, Strict),
+		`reader`: NewReaderDecoder(iotest.OneByteReader(bytes.NewReader(stream)), Strict),
+	} {
+		var dst DotNotation
+		var got []string
+		for dec.Next() {
+			dst = dec.DotNotation(dst)
+			if arcs, ok := dec.Uint64s(); ok && fmt.Sprint(arcs) != fmt.Sprint(dst.uint64s()) {
+				t.Errorf("%s failed [%s]: arcs %v do not match %s", t.Name(), name, arcs, dst)
+			}
+			got = append(got, dst.String())
+		}
+
+		if err := dec.Err(); err != nil {
+			t.Errorf("%s failed [%s]: %v", t.Name(), name, err)
+		} else if fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s failed [%s]:\nwant: %v\ngot:  %v", t.Name(), name, want, got)
+		}
+	}
+}
+
+func TestDecoder_Uint64s(t *testing.T) {
+	for _, tc := range []struct {
+		dot  string
+		fits bool
+	}{
+		{`1.3.6.1`, true},
+		{`2.18446744073709551535`, true},
+		{`2.18446744073709551536`, false},
+		{`1.3.18446744073709551615`, true},
+		{`1.3.18446744073709551616`, false},
+	} {
+		d, _ := NewDotNotation(tc.dot)
+		b, _ := d.Encode()
+
+		dec := NewDecoder(b)
+		dec.Buffer(make([]uint64, 0, 8))
+		if !dec.Next() {
+			t.Errorf("%s failed [%s]: %v", t.Name(), tc.dot, dec.Err())
+			continue
+		}
+
+		arcs, ok := dec.Uint64s()
+		if ok != tc.fits {
+			t.Errorf("%s failed [%s]: want fits %t, got %t", t.Name(), tc.dot, tc.fits, ok)
+		} else if ok && fmt.Sprint(arcs) != fmt.Sprint(d.uint64s()) {
+			t.Errorf("%s failed [%s]: want %v, got %v", t.Name(), tc.dot, d.uint64s(), arcs)
+		}
+	}
+}
+
+func TestDecoder_errors(t *testing.T) {
+	oid := []byte{0x06, 0x03, 0x2B, 0x06, 0x01}
+
+	for idx, tc := range []struct {
+		b      []byte
+		opts   []DecodeOption
+		n      int // OIDs read before failure
+		kind   error
+		offset int
+	}{
+		{append(oid, 0x04, 0x01, 0x00), nil, 1, ErrInvalidTag, 5},
+		{append(oid, 0x06), nil, 1, ErrTruncated, 6},
+		{append(oid, 0x06, 0x00), nil, 1, ErrTruncated, 7},
+		{append(oid, 0x06, 0x03, 0x2B), nil, 1, ErrTruncated, 8},
+		{append(oid, 0x06, 0x80), nil, 1, ErrIndefiniteLength, 6},
+		{append(oid, 0x06, 0x81, 0x02, 0x2B, 0x06), []DecodeOption{DERLength}, 1, ErrNonMinimalLength, 6},
+		{append(oid, 0x06, 0x02, 0x2B, 0x86), nil, 1, ErrTruncatedSubidentifier, 8},
+		{append(oid, 0x06, 0x03, 0x2B, 0x80, 0x01), []DecodeOption{MinimalSubidentifiers}, 1, ErrNonMinimalSubidentifier, 8},
+	} {
+		for name, dec := range map[string]*Decoder{
+			`bytes`:  NewDecoder(tc.b, tc.opts...),
+			`reader`: NewReaderDecoder(iotest.HalfReader(bytes.NewReader(tc.b)), tc.opts...),
+		} {
+			var n int
+			for dec.Next() {
+				n++
+			}
+
+			var de *DecodeError
+			if err := dec.Err(); !errors.As(err, &de) {
+				t.Errorf("%s[%d] failed [%s]: want *DecodeError, got %T (%v)", t.Name(), idx, name, err, err)
+			} else if n != tc.n || !errors.Is(err, tc.kind) || de.Offset != tc.offset {
+				t.Errorf("%s[%d] failed [%s]: want %d OIDs, %v at offset %d; got %d OIDs, %v",
+					t.Name(), idx, name, tc.n, tc.kind, tc.offset, n, err)
+			} else if dec.Next() {
+				t.Errorf("%s[%d] failed [%s]: Next succeeded following an error", t.Name(), idx, name)
+			}
+		}
+	}
+}
+
+func TestDecoder_Reset(t *testing.T) {
+	dec := NewDecoder([]byte{0x04})
+	if dec.Next() || dec.Err() == nil {
+		t.Errorf("%s failed: expected error", t.Name())
+	}
+
+	dec.Reset([]byte{0x06, 0x01, 0x2B})
+	if !dec.Next() || dec.DotNotation(nil).String() != `1.3` {
+		t.Errorf("%s failed: unexpected result following Reset: %v", t.Name(), dec.Err())
+	}
+
+	dec.ResetReader(iotest.ErrReader(io.ErrClosedPipe))
+	if dec.Next() || !errors.Is(dec.Err(), io.ErrClosedPipe) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), io.ErrClosedPipe, dec.Err())
+	}
+
+	dec.ResetReader(bytes.NewReader(nil))
+	if dec.Next() || dec.Err() != nil {
+		t.Errorf("%s failed: unexpected result for empty reader: %v", t.Name(), dec.Err())
+	}
+}
+
+func TestDecoder_longContent(t *testing.T) {
+	d := DotNotation{}
+	for i := 0; i < 3000; i++ {
+		d = append(d, NumberForm(*big.NewInt(int64(i%40 + 1))))
+	}
+	b, _ := d.Encode()
+
+	dec := NewReaderDecoder(bytes.NewReader(b), Strict)
+	if !dec.Next() {
+		t.Errorf("%s failed: %v", t.Name(), dec.Err())
+	} else if got := dec.DotNotation(nil); Compare(got, d) != 0 {
+		t.Errorf("%s failed: round trip mismatch", t.Name())
+	}
+}
+
+/*
+uint64s is a test helper returning the arcs of r as uint64 values.
+*/
+func (r DotNotation) uint64s() []uint64 {
+	s, _ := r.Uint64Slice()
+	return s
+}
+
+func BenchmarkDecoder_Next(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	enc, _ := d.Encode()
+	dec := NewDecoder(nil)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dec.Reset(enc)
+		if !dec.Next() {
+			b.Fatal(dec.Err())
+		}
+	}
+}
+
+func BenchmarkDecoder_DotNotation(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	enc, _ := d.Encode()
+	dec := NewDecoder(nil)
+	var dst DotNotation
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dec.Reset(enc)
+		if !dec.Next() {
+			b.Fatal(dec.Err())
+		}
+		dst = dec.DotNotation(dst)
+	}
+}
+
+func BenchmarkDecoder_reader(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	enc, _ := d.Encode()
+	stream := bytes.Repeat(enc, 64)
+	rd := bytes.NewReader(stream)
+	dec := NewReaderDecoder(rd)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !dec.Next() {
+			if err := dec.Err(); err != nil {
+				b.Fatal(err)
+			}
+			rd.Reset(stream)
+			dec.ResetReader(rd)
+		}
+	}
+}
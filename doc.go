@@ -3,11 +3,22 @@ Package objectid implements ASN.1 Object Identifier types and methods.
 
 # Features
 
-  - Unbounded [NumberForm] support
+  - Unbounded [NumberForm] support, including ITU-T Rec. X.667 UUID-based OIDs ([NewUUIDOID], [DotNotation.UUID])
+  - Canonical numeric arc text (no leading zeros), with lenient constructors for legacy data ([NewLenientNumberForm], [NewLenientDotNotation], [NewLenientOID])
   - ASN.1 encoding and decoding of [DotNotation] instances -- without use of the [encoding/asn1] package
+  - RELATIVE-OID support by way of the [RelativeOID] type
+  - OID-IRI (ITU-T Rec. X.680 clause 34) support by way of the [IRINotation] type
   - Flexible index support, allowing interrogation through negative indices without the risk of panic
   - Convenient Leaf, Parent and Root index alias methods, wherever applicable
   - Ge, Gt, Le, Lt, Equal comparison methods for interacting with [NumberForm] instances
+  - Hierarchical [Registry] of known arcs, allowing resolution of name-only arcs (e.g.: "{iso identified-organization dod internet}")
+  - Extraction of OBJECT IDENTIFIER value assignments from ASN.1 modules and SNMP SMIv1/SMIv2 MIBs, resolved across IMPORTS, by way of [ModuleSet]
+  - OpenLDAP objectIdentifier macro expansion and abbreviation by way of [MacroTable]
+  - RFC 4512 LDAP numericoid and descr parsing by way of [ParseNumericOID], [ParseDescr] and [ParseOIDOrDescr]
+  - Comparable [Key] representation of OIDs for use as map keys ([DotNotation.Key], [OID.Key])
+  - Generic prefix [Trie], keyed by [DotNotation], supporting longest-prefix matching and ordered subtree iteration
+  - Total ordering of [DotNotation], [ASN1Notation] and [OID] instances by way of [Compare], [CompareASN1] and [CompareOID]
+  - Typed, inspectable errors ([ParseError], [DecodeError]) for use with [errors.Is] and [errors.As]
   - Conversion friendly -- easy hand-off to [encoding/asn1.ObjectIdentifier] and [crypto/x509.OID] instances
 
 # License
@@ -30,6 +41,10 @@ ASN.1 codec features are written solely for [DotNotation] related cases (e.g.: "
 
   - Encoding (marshaling) of a [DotNotation] into an ASN.1 encoded value ([]byte{...})
   - Decoding (unmarshaling) of encoded values into an unpopulated [DotNotation] instance
+  - Content octets alone ([DotNotation.EncodeContent], [DotNotation.DecodeContent]), or bearing an implicit context-specific tag ([DotNotation.EncodeImplicit], [DotNotation.DecodeImplicit])
+  - Short and long form definite length octets, per ITU-T Rec. X.690 clause 8.1.3
+  - Optional strict decoding ([Strict]), rejecting non-minimal length and subidentifier encodings
+  - Streaming, allocation-free decoding of successive encoded values from a []byte or [io.Reader] by way of [Decoder]
 
 Encoding of non-minimal values -- such as root arcs "0", "1" and "2" alone -- is not supported.  Some ASN.1 implementations precariously treat certain OIDs, such as "0" and "0.0" the same, likely for support reasons. This results in ambiguity when handling pre-encoded bytes in an obverse scenario, and is in violation of ITU-T Rec. X.690 regarding the proper encoding of an ASN.1 OBJECT IDENTIFIER.
 
@@ -1,6 +1,9 @@
 package objectid
 
-import "math/big"
+import (
+	"math"
+	"math/big"
+)
 
 /*
 DotNotation contains an ordered sequence of [NumberForm] instances.
@@ -13,12 +16,15 @@ receiver (e.g.: "1.3.6.1").
 */
 func (r DotNotation) String() (s string) {
 	if !r.IsZero() {
-		var x []string
+		b := make([]byte, 0, 4*len(r))
 		for i := 0; i < len(r); i++ {
-			x = append(x, r[i].String())
+			if i > 0 {
+				b = append(b, '.')
+			}
+			b = r[i].appendText(b)
 		}
 
-		s = join(x, `.`)
+		s = string(b)
 	}
 	return
 }
@@ -62,8 +68,7 @@ func (r *DotNotation) IsZero() (is bool) {
 }
 
 /*
-NewDotNotation returns an instance of *[DotNotation] alongside a Boolean
-value indicative of success.
+NewDotNotation returns an instance of *[DotNotation] alongside an error.
 
 Variadic input allows for slice mixtures of all of the following types,
 each treated as an individual [NumberForm] instance:
@@ -76,14 +81,32 @@ each treated as an individual [NumberForm] instance:
   - int
 
 If a string primitive is the only input option, it will be treated as a
-complete [DotNotation] (e.g.: "1.3.6").
+complete [DotNotation] (e.g.: "1.3.6"). Numeric arc text must not bear
+leading zeros; see [NewLenientDotNotation] for legacy data.
+
+Any error returned is a *[ParseError], which wraps one of the exported
+parsing errors (e.g.: [ErrInvalidRoot]) for use with [errors.Is].
 */
 func NewDotNotation(x ...any) (r *DotNotation, err error) {
+	return newDotNotation(x, false)
+}
+
+/*
+NewLenientDotNotation returns an instance of *[DotNotation] alongside an
+error in the same manner as [NewDotNotation], except that numeric arc text
+may bear leading zeros, which are discarded (e.g.: "1.3.06.1" yields
+"1.3.6.1"), for the benefit of legacy data.
+*/
+func NewLenientDotNotation(x ...any) (r *DotNotation, err error) {
+	return newDotNotation(x, true)
+}
+
+func newDotNotation(x []any, lenient bool) (r *DotNotation, err error) {
 	var _d DotNotation = make(DotNotation, 0)
 
 	if len(x) == 1 {
 		if slice, ok := x[0].(string); ok {
-			r, err = newDotNotationStr(slice)
+			r, err = newDotNotationStr(slice, lenient)
 			return
 		}
 	}
@@ -93,14 +116,16 @@ func NewDotNotation(x ...any) (r *DotNotation, err error) {
 		switch tv := x[i].(type) {
 		case NumberForm:
 			if !tv.Valid() {
-				err = errorf("Unsupported %T value", tv)
+				err = parseErr(ErrEmpty, tv.String(), i, -1)
 				break
 			}
 			nf = tv
 		case *big.Int, string, uint64, uint, int:
-			nf, err = NewNumberForm(tv)
+			if nf, err = newNumberForm(tv, lenient); err != nil {
+				err = reparse(err, ``, i, -1)
+			}
 		default:
-			err = errorf("Unsupported slice type '%T' for OID", tv)
+			err = parseErr(ErrUnsupportedType, sprintf("%T", tv), i, -1)
 		}
 
 		_d = append(_d, nf)
@@ -114,22 +139,39 @@ func NewDotNotation(x ...any) (r *DotNotation, err error) {
 	return
 }
 
-func newDotNotationStr(dot string) (r *DotNotation, err error) {
-	if !isNumericOID(dot) {
-		err = errorf("Invalid OID '%s' cannot be processed", dot)
+func newDotNotationStr(dot string, lenient bool) (r *DotNotation, err error) {
+	if len(dot) == 0 {
+		err = parseErr(ErrEmpty, dot, -1, -1)
 		return
 	}
-	z := split(dot, `.`)
 
-	_d := make(DotNotation, 0)
-	for j := 0; j < len(z) && err == nil; j++ {
+	L := 1
+	for i := 0; i < len(dot); i++ {
+		if dot[i] == '.' {
+			L++
+		}
+	}
+
+	// all arcs which fit a uint64 share
+	// one (1) allocation.
+	slab := newArcSlab(L)
+	_d := make(DotNotation, 0, L)
+	for start, j := 0, 0; j < L; j++ {
+		end := start + indexByte(dot[start:], '.')
+		if end < start {
+			end = len(dot)
+		}
+
 		var nf NumberForm
-		if nf, err = NewNumberForm(z[j]); err == nil {
-			_d = append(_d, nf)
+		if nf, err = parseNF(dot[start:end], &slab, lenient); err != nil {
+			err = reparse(err, dot, j, start)
+			return
 		}
+		_d = append(_d, nf)
+		start = end + 1
 	}
 
-	if err == nil {
+	if err = _d.validPrefix(dot); err == nil {
 		r = new(DotNotation)
 		*r = _d
 	}
@@ -137,55 +179,151 @@ func newDotNotationStr(dot string) (r *DotNotation, err error) {
 	return
 }
 
+/*
+validPrefix returns an error describing the first reason for which the
+root and second arcs of the receiver instance violate ITU-T Rec. X.660,
+if any. The dot value is the dot notation form of the receiver, and is
+used solely for error reporting.
+*/
+func (r DotNotation) validPrefix(dot string) (err error) {
+	switch {
+	case len(r) < 2:
+		err = parseErr(ErrTooFewArcs, dot, -1, -1)
+	case r[0].Gt(2):
+		err = parseErr(ErrInvalidRoot, dot, 0, 0)
+	case r[0].Lt(2) && r[1].Gt(39):
+		err = parseErr(ErrInvalidSecondArc, dot, 1, indexByte(dot, '.')+1)
+	}
+
+	return
+}
+
 /*
 Encode returns the ASN.1 encoding of the receiver instance alongside an error.
 */
 func (r DotNotation) Encode() (b []byte, err error) {
+	var content []byte
+	if content, err = r.encodeContent(); err == nil {
+		b = encodeTLV(content, 0x06) // ASN.1 Object Identifier Tag (0x06)
+	}
+
+	return
+}
+
+/*
+EncodeContent returns the ASN.1 content octets of the receiver instance,
+absent the identifier (tag) and length octets, alongside an error. This
+is the form used wherever the tag and length are conveyed by other means,
+such as a PKCS #11 CKA_OBJECT_ID attribute value.
+
+See also [DotNotation.DecodeContent].
+*/
+func (r DotNotation) EncodeContent() ([]byte, error) {
+	return r.encodeContent()
+}
+
+/*
+EncodeImplicit returns the ASN.1 encoding of the receiver instance, bearing
+the context-specific tag number n in place of the OBJECT IDENTIFIER tag, as
+would a "[n] IMPLICIT OBJECT IDENTIFIER" value, alongside an error.
+
+See also [DotNotation.DecodeImplicit].
+*/
+func (r DotNotation) EncodeImplicit(n uint) (b []byte, err error) {
+	var content []byte
+	if content, err = r.encodeContent(); err == nil {
+		b = encodeTLV(content, contextTag(n)...)
+	}
+
+	return
+}
+
+/*
+encodeTLV returns the input content octets, preceded by the input
+identifier (tag) octets and the appropriate definite length octets.
+*/
+func encodeTLV(content []byte, id ...byte) (b []byte) {
+	b = make([]byte, 0, len(content)+len(id)+5)
+	b = append(b, id...)
+	b = appendLength(b, len(content))
+	b = append(b, content...)
+
+	return
+}
+
+/*
+contextTag returns the identifier octets of the primitive, context-specific
+tag number n. Tag numbers of thirty-one (31) or greater use the high tag
+number form, per ITU-T Rec. X.690 clause 8.1.2.4.
+*/
+func contextTag(n uint) []byte {
+	if n < 31 {
+		return []byte{0x80 | byte(n)}
+	}
+
+	return appendVLQ([]byte{0x9F}, uint64(n))
+}
+
+/*
+encodeContent returns the ASN.1 content octets of the receiver instance,
+absent any tag or length octets, alongside an error.
+
+Per ITU-T Rec. X.690 clause 8.19.4, the first two (2) arcs are combined
+into a single subidentifier: (X*40)+Y. Only joint-iso-itu-t(2) allows for
+a second-level arc greater than thirty-nine (39).
+*/
+func (r DotNotation) encodeContent() (b []byte, err error) {
 	if r.Len() < 2 {
 		err = errorf("Length below encoding minimum")
 		return
+	} else if !r[0].Lt(3) {
+		err = errorf("Root arc must be one of 0, 1 or 2")
+		return
+	} else if r[0].Lt(2) && r[1].Gt(39) {
+		err = errorf("Only joint-iso-itu-t(2) OIDs allow second-level arcs > 39")
+		return
 	}
 
-	var start int
-	firstArc := r[0].cast()
-	forty := big.NewInt(40)
-	firstArc.Mul(firstArc, forty)
-
-	if r[1].cast().Cmp(forty) < 1 {
-		// this is meant for second level arcs <= 39
-		firstArc.Add(firstArc, r[1].cast()) // first + arc2
-		if firstBytes := firstArc.Bytes(); len(firstBytes) == 0 {
-			// We need the explicit zero byte, not an
-			// empty []byte{} instance.  This effort
-			// is really needed for OID "0.0".
-			b = append([]byte{0x00}, b...)
-		} else {
-			b = append(firstBytes, b...)
-		}
-		start = 2
+	b = make([]byte, 0, 2*len(r))
+	if x, ok := r[1].small(); ok && x <= math.MaxUint64-80 {
+		y, _ := r[0].small()
+		b = appendVLQ(b, y*40+x) // (first * 40) + arc2
 	} else {
-		if r[0].cast().Uint64() != 2 {
-			err = errorf("Only joint-iso-itu-t(2) OIDs allow second-level arcs > 39")
-			return
-		}
-
-		// Multi-Byte encoding for second-level arcs
-		// below joint-iso-itu-t(2), such as "999" for
-		// "2.999", that are larger than 39.  Instead,
-		// skip the Addition operation we'd normally
-		// perform, and just begin VLQ encoding each
-		// subsequent byte.
-		start = 1
+		firstArc := big.NewInt(0).Mul(r[0].cast(), big.NewInt(40))
+		firstArc.Add(firstArc, r[1].cast()) // (first * 40) + arc2
+		b = append(b, encodeVLQ(firstArc.Bytes())...)
 	}
 
-	if len(r) > start {
-		for i := start; i < len(r); i++ {
-			b = append(b, encodeVLQ(r[i].cast().Bytes())...)
-		}
+	for i := 2; i < len(r); i++ {
+		b = r[i].appendVLQ(b)
 	}
 
-	b = append([]byte{byte(len(b))}, b...) // byte representation of int length of byte slice b
-	b = append([]byte{0x06}, b...)         // ASN.1 Object Identifier Tag (0x06)
+	return
+}
+
+/*
+DecodeOption values may be supplied to the [DotNotation.Decode] method
+to alter its default (lenient BER) behavior.
+*/
+type DecodeOption uint8
+
+const (
+	// DERLength requires that length octets be encoded in the minimum
+	// number of octets possible, per ITU-T Rec. X.690 clause 10.1.
+	DERLength DecodeOption = 1 << iota
+
+	// MinimalSubidentifiers requires that no subidentifier begin with
+	// a padding octet of 0x80, per ITU-T Rec. X.690 clause 8.19.2.
+	MinimalSubidentifiers
+
+	// Strict enables all available conformance checks.
+	Strict = DERLength | MinimalSubidentifiers
+)
+
+func decodeOptions(opts []DecodeOption) (o DecodeOption) {
+	for i := 0; i < len(opts); i++ {
+		o |= opts[i]
+	}
 
 	return
 }
@@ -193,79 +331,214 @@ func (r DotNotation) Encode() (b []byte, err error) {
 /*
 Decode returns an error following an attempt to parse b, which must be
 the ASN.1 encoding of an OID, into the receiver instance. The receiver
-instance is reinitialized at runtime.
+instance is reinitialized upon success.
+
+Both the short and long definite length forms are honored. The indefinite
+length form is not permitted for an OBJECT IDENTIFIER, and is rejected.
+
+Optional [DecodeOption] values may be supplied to enforce stricter rules,
+such as [Strict]. Any error returned is a *[DecodeError], which wraps one
+of the exported decoding errors (e.g.: [ErrNonMinimalSubidentifier]) for
+use with [errors.Is].
 */
-func (r *DotNotation) Decode(b []byte) (err error) {
-	if len(b) < 3 {
-		err = errorf("Truncated OID encoding")
-		return
+func (r *DotNotation) Decode(b []byte, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts), 0x06)
+}
+
+/*
+DecodeContent returns an error following an attempt to parse b, which must
+be the content octets of an ASN.1 encoded OID -- absent any identifier (tag)
+and length octets -- into the receiver instance. The receiver instance is
+reinitialized upon success.
+
+Optional [DecodeOption] values are honored as they would be by the
+[DotNotation.Decode] method, though only [MinimalSubidentifiers] applies.
+
+See also [DotNotation.EncodeContent].
+*/
+func (r *DotNotation) DecodeContent(b []byte, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts))
+}
+
+/*
+DecodeImplicit returns an error following an attempt to parse b, which must
+be the ASN.1 encoding of an OID bearing the context-specific tag number n in
+place of the OBJECT IDENTIFIER tag, as would a "[n] IMPLICIT OBJECT IDENTIFIER"
+value, into the receiver instance. The receiver instance is reinitialized upon
+success.
+
+Optional [DecodeOption] values are honored as they would be by the
+[DotNotation.Decode] method.
+
+See also [DotNotation.EncodeImplicit].
+*/
+func (r *DotNotation) DecodeImplicit(b []byte, n uint, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts), contextTag(n)...)
+}
+
+/*
+decode parses b, which must bear the identifier octets id, into the
+receiver instance. If id is zero length, b is treated as content octets.
+*/
+func (r *DotNotation) decode(b []byte, o DecodeOption, id ...byte) (err error) {
+	var content []byte
+	var hdr int
+	if content, hdr, err = decodeHeader(b, o, id...); err == nil {
+		var d DotNotation
+		if d, err = decodeSubidentifiers(content, hdr, o&MinimalSubidentifiers != 0, true); err == nil {
+			*r = d
+		}
 	}
 
-	if b[0] != 0x06 {
-		err = errorf("Invalid ASN.1 Tag; want: 0x06")
+	err = decodeInput(err, b)
+
+	return
+}
+
+/*
+decodeHeader verifies the identifier (tag) octets id and the length octets
+at the beginning of b, returning the content octets alongside the offset at
+which they begin and an error. If id is zero length, b is returned as the
+content octets, provided it is not also zero length.
+*/
+func decodeHeader(b []byte, o DecodeOption, id ...byte) (content []byte, hdr int, err error) {
+	if len(id) == 0 {
+		if content = b; len(b) == 0 {
+			err = decodeErr(ErrTruncated, 0)
+		}
 		return
 	}
 
-	length := int(b[1])
-	b = b[2:]
+	for i := 0; i < len(id) && i < len(b); i++ {
+		if b[i] != id[i] {
+			err = decodeErr(ErrInvalidTag, i)
+			return
+		}
+	}
+
+	if len(b) < len(id)+2 {
+		err = decodeErr(ErrTruncated, len(b))
+		return
+	}
 
-	if length != len(b) {
-		err = errorf("Length of bytes does not match with the indicated length")
+	var length, n int
+	if length, n, err = decodeLength(b[len(id):], len(id), o&DERLength != 0); err != nil {
 		return
 	}
+	hdr = len(id) + n
+
+	switch avail := len(b) - hdr; {
+	case length == 0:
+		err = decodeErr(ErrTruncated, hdr)
+	case length > avail:
+		err = decodeErr(ErrLengthMismatch, len(b))
+	case length < avail:
+		err = decodeErr(ErrTrailingData, hdr+length)
+	default:
+		content = b[hdr:]
+	}
+
+	return
+}
+
+/*
+decodeSubidentifiers returns the sequence of VLQ encoded subidentifiers
+found within the content octets b alongside an error. The off value is
+the offset of b within the original input, used for error reporting. If
+minimal is true, subidentifiers bearing a leading 0x80 octet are rejected.
+If first is true, the first subidentifier is split into the first two (2)
+arcs, per ITU-T Rec. X.690 clause 8.19.4.
+*/
+func decodeSubidentifiers(b []byte, off int, minimal, first bool) (d DotNotation, err error) {
+	// count the subidentifiers, such that all
+	// arcs which fit a uint64 share one (1)
+	// allocation.
+	var L int
+	for i := 0; i < len(b); i++ {
+		if b[i]&0x80 == 0 {
+			L++
+		}
+	}
+	if first {
+		L++
+	}
 
 	var (
-		i             int
-		subidentifier *big.Int = big.NewInt(0)
+		start bool = true
+		v     uint64
+		huge  *big.Int // non-nil once v overflows
+		slab  arcSlab  = newArcSlab(L)
 	)
 
-	*r = make(DotNotation, 0)
+	d = make(DotNotation, 0, L)
+	for i := 0; i < len(b); i++ {
+		if start && b[i] == 0x80 && minimal {
+			err = decodeErr(ErrNonMinimalSubidentifier, off+i)
+			return
+		}
 
-	for i < len(b) {
-		for {
-			subidentifier.Lsh(subidentifier, 7)
-			subidentifier.Add(subidentifier, big.NewInt(int64(b[i]&0x7F)))
-			if b[i]&0x80 == 0 {
-				break
-			}
-			i++
+		if huge == nil && v>>57 != 0 {
+			huge = new(big.Int).SetUint64(v)
 		}
 
-		i++
-		*r = append(*r, NumberForm(*subidentifier))
-		subidentifier = big.NewInt(0)
+		if huge != nil {
+			huge.Lsh(huge, 7)
+			huge.Or(huge, big.NewInt(int64(b[i]&0x7F)))
+		} else {
+			v = v<<7 | uint64(b[i]&0x7F)
+		}
+
+		if start = b[i]&0x80 == 0; start {
+			switch {
+			case first && len(d) == 0:
+				d = appendFirstArcs(d, v, huge, &slab)
+			case huge != nil:
+				d = append(d, NumberForm(*huge))
+			default:
+				d = append(d, slab.numberForm(v))
+			}
+			v, huge = 0, nil
+		}
 	}
 
-	if len(*r) > 0 {
-		r.decodeFirstArcs(b[0])
+	if !start {
+		err = decodeErr(ErrTruncatedSubidentifier, off+len(b)-1)
 	}
 
 	return
 }
 
-func (r *DotNotation) decodeFirstArcs(b byte) {
-	var firstArc *big.Int
-	var secondArc *big.Int
-
-	var forty *big.Int = big.NewInt(40)
-	var eighty *big.Int = big.NewInt(80)
-
-	if (*r)[0].cast().Cmp(big.NewInt(80)) < 0 {
-		firstArc = big.NewInt(0).Div((*r)[0].cast(), forty)
-		secondArc = big.NewInt(0).Mod((*r)[0].cast(), forty)
-	} else {
-		firstArc = big.NewInt(2)
-		if b >= 0x80 {
-			// Handle large second-level arcs
-			secondArc = big.NewInt(0).Sub((*r)[0].cast(), firstArc)
-			secondArc.Add(secondArc, firstArc)
-		} else {
-			secondArc = big.NewInt(0).Sub((*r)[0].cast(), eighty)
-		}
+/*
+appendFirstArcs appends the first two (2) arcs, derived from the first
+subidentifier, to d, per ITU-T Rec. X.690 clause 8.19.4. The subidentifier
+is v, unless huge is non-nil.
+*/
+func appendFirstArcs(d DotNotation, v uint64, huge *big.Int, slab *arcSlab) DotNotation {
+	if huge == nil {
+		x, y := splitFirstArcs(v)
+		return append(d, slab.numberForm(x), slab.numberForm(y))
 	}
 
-	(*r)[0] = NumberForm(*secondArc)
-	*r = append(DotNotation{NumberForm(*firstArc)}, *r...)
+	second := new(big.Int).Sub(huge, big.NewInt(80))
+	return append(d, slab.numberForm(2), NumberForm(*second))
+}
+
+/*
+splitFirstArcs returns the first two (2) arcs encoded within the first
+subidentifier v, per ITU-T Rec. X.690 clause 8.19.4.
+*/
+func splitFirstArcs(v uint64) (x, y uint64) {
+	switch {
+	case v < 40:
+		return 0, v
+	case v < 80:
+		return 1, v - 40
+	}
+
+	// joint-iso-itu-t(2) allows for second-level
+	// arcs of any magnitude, such as "999" for
+	// "2.999".
+	return 2, v - 80
 }
 
 /*
@@ -487,13 +760,104 @@ func (r DotNotation) Valid() (is bool) {
 	return
 }
 
+/*
+encodeLength returns the definite length octets for content of length n,
+per ITU-T Rec. X.690 clause 8.1.3. The short form is used for lengths of
+127 or less, otherwise the long form is used with the minimum number of
+subsequent octets, which satisfies DER.
+*/
+func encodeLength(n int) []byte {
+	return appendLength(nil, n)
+}
+
+/*
+appendLength appends the definite length octets for content of length n
+to b, returning the extended slice. See [encodeLength].
+*/
+func appendLength(b []byte, n int) []byte {
+	if n < 0x80 {
+		return append(b, byte(n))
+	}
+
+	var l int
+	for x := n; x > 0; x >>= 8 {
+		l++
+	}
+
+	b = append(b, 0x80|byte(l))
+	for i := l - 1; i >= 0; i-- {
+		b = append(b, byte(n>>(8*i)))
+	}
+
+	return b
+}
+
+/*
+decodeLength reads the definite length octets at the beginning of b and
+returns the indicated content length alongside the number of octets read
+and an error. The indefinite form and the reserved initial octet 0xFF are
+rejected. If der is true, the length octets must also be minimal. The off
+value is the offset of b within the original input, used for reporting.
+*/
+func decodeLength(b []byte, off int, der bool) (length, n int, err error) {
+	if len(b) == 0 {
+		err = decodeErr(ErrTruncated, off)
+		return
+	}
+
+	switch first := b[0]; {
+	case first < 0x80:
+		length, n = int(first), 1
+		return
+	case first == 0x80:
+		err = decodeErr(ErrIndefiniteLength, off)
+		return
+	case first == 0xFF:
+		err = decodeErr(ErrReservedLength, off)
+		return
+	}
+
+	octets := int(b[0] & 0x7F)
+	if len(b) < 1+octets {
+		err = decodeErr(ErrTruncated, off+len(b))
+		return
+	}
+
+	if der && b[1] == 0x00 {
+		err = decodeErr(ErrNonMinimalLength, off+1)
+		return
+	}
+
+	for i := 1; i <= octets; i++ {
+		// guard against lengths that could never
+		// be satisfied by any in-memory slice.
+		if length > (int(^uint(0)>>1) >> 8) {
+			err = decodeErr(ErrLengthOverflow, off+i)
+			return
+		}
+		length = length<<8 | int(b[i])
+	}
+
+	if der && length < 0x80 {
+		err = decodeErr(ErrNonMinimalLength, off)
+		return
+	}
+
+	n = 1 + octets
+
+	return
+}
+
 /*
 encodeVLQ returns the VLQ -- or Variable Length Quantity -- encoding of
-the raw input value.
+the raw input value. A zero value is encoded as a single 0x00 octet.
 */
 func encodeVLQ(b []byte) []byte {
 	var oid []byte
 	n := big.NewInt(0).SetBytes(b)
+	if n.Sign() == 0 {
+		return []byte{0x00}
+	}
 
 	for n.Cmp(big.NewInt(0)) > 0 {
 		temp := new(big.Int)
@@ -509,23 +873,22 @@ func encodeVLQ(b []byte) []byte {
 }
 
 func isNumericOID(id string) bool {
+	return isNumericOIDText(id, false)
+}
+
+/*
+isNumericOIDText returns a Boolean value indicative of whether id is a
+numeric OID bearing a valid prefix. Unless loose is true, all arcs must
+also be canonical, bearing no leading zeros.
+*/
+func isNumericOIDText(id string, loose bool) bool {
 	if !isValidOIDPrefix(id) {
 		return false
 	}
 
-	var last rune
-	for i, c := range id {
-		switch {
-		case c == '.':
-			if last == c {
-				return false
-			} else if i == len(id)-1 {
-				return false
-			}
-			last = '.'
-		case ('0' <= c && c <= '9'):
-			last = c
-			continue
+	for _, arc := range split(id, `.`) {
+		if !isNumber(arc) || !(loose || isCanonicalNumber(arc)) {
+			return false
 		}
 	}
 
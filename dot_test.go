@@ -1,6 +1,8 @@
 package objectid
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -80,6 +82,107 @@ func ExampleDotNotation_Decode() {
 
 }
 
+/*
+This example demonstrates use of the [DotNotation.DecodeContent] method,
+which decodes content octets lacking the identifier (tag) and length octets,
+such as a PKCS #11 CKA_OBJECT_ID attribute value.
+*/
+func ExampleDotNotation_DecodeContent() {
+	var d DotNotation
+
+	// content octets for OID 1.3.6.1.4.1.56521.999.5
+	b := []byte{0x2b, 0x6, 0x1, 0x4, 0x1, 0x83, 0xb9, 0x49, 0x87, 0x67, 0x5}
+
+	if err := d.DecodeContent(b); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s\n", d)
+	// Output: 1.3.6.1.4.1.56521.999.5
+}
+
+/*
+This example demonstrates use of the [DotNotation.EncodeImplicit] method,
+as would be used for a "[0] IMPLICIT OBJECT IDENTIFIER" value, such as the
+registeredID form of an X.509 GeneralName (which uses tag [8]).
+*/
+func ExampleDotNotation_EncodeImplicit() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	b, err := dot.EncodeImplicit(8)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%#x", b)
+	// Output: 0x88082b0601040183b949
+}
+
+func TestDotNotation_contentCodec(t *testing.T) {
+	for _, dot := range []string{
+		`1.3.6.1.4.1.56521.999.5`,
+		`2.25.329800735698586629295641978511506172918`,
+		`0.0`,
+	} {
+		d, _ := NewDotNotation(dot)
+		content, err := d.EncodeContent()
+		if err != nil {
+			t.Errorf("%s failed [%s]: %v", t.Name(), dot, err)
+			continue
+		}
+
+		enc, _ := d.Encode()
+		if !bytes.Equal(enc[len(enc)-len(content):], content) {
+			t.Errorf("%s failed [%s]: content %x is not a suffix of %x", t.Name(), dot, content, enc)
+		}
+
+		var e DotNotation
+		if err = e.DecodeContent(content, Strict); err != nil || e.String() != dot {
+			t.Errorf("%s failed [%s]: got %s, %v", t.Name(), dot, e, err)
+		}
+
+		for _, tag := range []uint{0, 8, 30, 31, 127, 128, 16384} {
+			if enc, err = d.EncodeImplicit(tag); err != nil {
+				t.Errorf("%s failed [%s, tag %d]: %v", t.Name(), dot, tag, err)
+				continue
+			}
+
+			var f DotNotation
+			if err = f.DecodeImplicit(enc, tag, Strict); err != nil || f.String() != dot {
+				t.Errorf("%s failed [%s, tag %d]: got %s, %v", t.Name(), dot, tag, f, err)
+			} else if err = f.DecodeImplicit(enc, tag+1); !errors.Is(err, ErrInvalidTag) {
+				t.Errorf("%s failed [%s, tag %d]: want %v, got %v", t.Name(), dot, tag, ErrInvalidTag, err)
+			} else if err = f.Decode(enc); !errors.Is(err, ErrInvalidTag) {
+				t.Errorf("%s failed [%s, tag %d]: want %v, got %v", t.Name(), dot, tag, ErrInvalidTag, err)
+			}
+		}
+	}
+
+	var d DotNotation
+	for idx, tc := range []struct {
+		b    []byte
+		kind error
+	}{
+		{nil, ErrTruncated},
+		{[]byte{0x2b, 0x86}, ErrTruncatedSubidentifier},
+		{[]byte{0x2b, 0x80, 0x01}, ErrNonMinimalSubidentifier},
+	} {
+		if err := d.DecodeContent(tc.b, Strict); !errors.Is(err, tc.kind) {
+			t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, tc.kind, err)
+		}
+	}
+
+	// a high tag number must be encoded minimally.
+	if err := d.DecodeImplicit([]byte{0x9F, 0x80, 0x1F, 0x01, 0x2B}, 31); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidTag, err)
+	}
+
+	if _, err := (DotNotation{}).EncodeContent(); err == nil {
+		t.Errorf("%s failed: expected error for zero length input", t.Name())
+	}
+}
+
 func ExampleDotNotation_IsZero() {
 	var dot DotNotation
 	fmt.Printf("Is Zero: %t", dot.IsZero())
@@ -404,3 +507,195 @@ func (r *DotNotation) encode2Decode(key string, slice []byte, t *testing.T) {
 		}
 	}
 }
+
+func TestDotNotation_longFormLength(t *testing.T) {
+	// 2.25 followed by enough large arcs to push the
+	// content length beyond the short form maximum.
+	raw := `2.25`
+	for i := 0; i < 12; i++ {
+		raw += `.987895962269883002155146617097157934`
+	}
+
+	dot, err := NewDotNotation(raw)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var b []byte
+	if b, err = dot.Encode(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if b[1] != 0x81 || int(b[2]) != len(b)-3 {
+		t.Errorf("%s failed: unexpected length octets %#x %#x", t.Name(), b[1], b[2])
+		return
+	}
+
+	var d DotNotation
+	if err = d.Decode(b, DERLength); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if d.String() != raw {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), raw, d)
+		return
+	}
+
+	// non-minimal, but valid BER, length octets
+	for _, nonMin := range [][]byte{
+		{0x06, 0x81, 0x03, 0x2b, 0x06, 0x01},
+		{0x06, 0x82, 0x00, 0x03, 0x2b, 0x06, 0x01},
+	} {
+		if err = d.Decode(nonMin); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		} else if err = d.Decode(nonMin, DERLength); err == nil {
+			t.Errorf("%s failed: expected DER length error, got nothing", t.Name())
+			return
+		}
+	}
+
+	for _, bad := range [][]byte{
+		{0x06, 0x80, 0x2b, 0x06, 0x01, 0x00, 0x00}, // indefinite
+		{0x06, 0xff, 0x2b, 0x06, 0x01},             // reserved
+		{0x06, 0x84, 0x00, 0x00},                   // truncated length octets
+		{0x06, 0x81, 0x00, 0x2b},                   // mismatched length
+	} {
+		if err = d.Decode(bad); err == nil {
+			t.Errorf("%s failed: expected error for %#v, got nothing", t.Name(), bad)
+			return
+		}
+	}
+}
+
+func TestDotNotation_DecodeStrict(t *testing.T) {
+	var d DotNotation
+	for idx, tc := range []struct {
+		b      []byte
+		lenErr error
+		strErr error
+	}{
+		{[]byte{0x06, 0x03, 0x2b, 0x80, 0x06}, nil, ErrNonMinimalSubidentifier},
+		{[]byte{0x06, 0x03, 0x80, 0x2b, 0x06}, nil, ErrNonMinimalSubidentifier},
+		{[]byte{0x06, 0x02, 0x2b, 0x86}, ErrTruncatedSubidentifier, ErrTruncatedSubidentifier},
+		{[]byte{0x06, 0x01, 0x2b, 0x06}, ErrTrailingData, ErrTrailingData},
+		{[]byte{0x06, 0x03, 0x2b, 0x06}, ErrLengthMismatch, ErrLengthMismatch},
+		{[]byte{0x06, 0x81, 0x02, 0x2b, 0x06}, nil, ErrNonMinimalLength},
+		{[]byte{0x06, 0x80, 0x2b, 0x06}, ErrIndefiniteLength, ErrIndefiniteLength},
+		{[]byte{0x05, 0x02, 0x2b, 0x06}, ErrInvalidTag, ErrInvalidTag},
+		{[]byte{0x06, 0x02, 0x2b, 0x06}, nil, nil},
+	} {
+		for _, pair := range []struct {
+			err  error
+			opts []DecodeOption
+		}{
+			{tc.lenErr, nil},
+			{tc.strErr, []DecodeOption{Strict}},
+		} {
+			err := d.Decode(tc.b, pair.opts...)
+			if pair.err == nil {
+				if err != nil {
+					t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+				}
+				continue
+			}
+
+			var de *DecodeError
+			if !errors.Is(err, pair.err) {
+				t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, pair.err, err)
+			} else if !errors.As(err, &de) {
+				t.Errorf("%s[%d] failed: %T is not a %T", t.Name(), idx, err, de)
+			}
+		}
+	}
+}
+
+func TestDotNotation_firstArcs(t *testing.T) {
+	for key, want := range map[string][]byte{
+		`0.0`:     {0x06, 0x01, 0x00},
+		`0.39`:    {0x06, 0x01, 0x27},
+		`1.3.0`:   {0x06, 0x02, 0x2b, 0x00},
+		`2.41`:    {0x06, 0x01, 0x79},
+		`2.999`:   {0x06, 0x02, 0x88, 0x37},
+		`2.999.3`: {0x06, 0x03, 0x88, 0x37, 0x03},
+	} {
+		dot, _ := NewDotNotation(key)
+		b, err := dot.Encode()
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if !bytes.Equal(b, want) {
+			t.Errorf("%s failed: %s want %#v, got %#v", t.Name(), key, want, b)
+			continue
+		}
+
+		var d DotNotation
+		if err = d.Decode(b, Strict); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if d.String() != key {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), key, d)
+		}
+	}
+}
+
+var benchDot = `1.3.6.1.4.1.56521.999.5.1.2.3`
+
+func BenchmarkNewDotNotation(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewDotNotation(benchDot); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDotNotation_Encode(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := d.Encode(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDotNotation_Decode(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	enc, _ := d.Encode()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var x DotNotation
+		if err := x.Decode(enc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDotNotation_String(b *testing.B) {
+	d, _ := NewDotNotation(benchDot)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkDotNotation_String_uuid(b *testing.B) {
+	d, _ := NewDotNotation(`2.25.329800735698586629295641978511506172918`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	x, _ := NewDotNotation(benchDot)
+	y, _ := NewDotNotation(benchDot + `.1`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Compare(*x, *y)
+	}
+}
@@ -0,0 +1,202 @@
+package objectid
+
+/*
+errors.go contains exported error values and types, allowing callers
+to inspect failures using errors.Is and errors.As.
+*/
+
+import "errors"
+
+/*
+Decoding errors returned (within a *[DecodeError]) by [DotNotation.Decode]
+and [RelativeOID.Decode].
+*/
+var (
+	ErrTruncated               = errors.New("Truncated OID encoding")
+	ErrInvalidTag              = errors.New("Unexpected ASN.1 Tag")
+	ErrIndefiniteLength        = errors.New("Indefinite ASN.1 length form not permitted for OID")
+	ErrReservedLength          = errors.New("Reserved ASN.1 length octet 0xFF")
+	ErrNonMinimalLength        = errors.New("Non-minimal ASN.1 length octets")
+	ErrLengthOverflow          = errors.New("ASN.1 length overflows int")
+	ErrLengthMismatch          = errors.New("Length of bytes does not match with the indicated length")
+	ErrTrailingData            = errors.New("Trailing octets follow the indicated length")
+	ErrNonMinimalSubidentifier = errors.New("Non-minimal subidentifier encoding (leading 0x80 octet)")
+	ErrTruncatedSubidentifier  = errors.New("Truncated subidentifier (final octet has high bit set)")
+)
+
+/*
+Parsing errors returned (within a *[ParseError]) by [NewNumberForm],
+[NewNameAndNumberForm], [NewDotNotation], [NewASN1Notation], [NewOID],
+[NewRelativeOID], [DotNotation.Relative], [Registry.Register] and
+[Registry.ResolveASN1], as well as by the X.660 setter methods of
+[NameAndNumberForm].
+*/
+var (
+	ErrEmpty             = errors.New("Zero length value")
+	ErrNegative          = errors.New("NumberForm cannot be negative")
+	ErrInvalidNumber     = errors.New("NumberForm must consist solely of decimal digits")
+	ErrLeadingZero       = errors.New("Non-canonical NumberForm: leading zeros are not permitted")
+	ErrInvalidIdentifier = errors.New("Invalid identifier; syntax must conform to: LOWER *[ [-] +[ UPPER / LOWER / DIGIT ] ]")
+	ErrInvalidSyntax     = errors.New("Malformed NameAndNumberForm; expected identifier(number), number or a root abbreviation")
+	ErrUnresolvedArc     = errors.New("Unable to resolve name-only arc")
+	ErrTooFewArcs        = errors.New("At least two (2) arcs are required")
+	ErrInvalidRoot       = errors.New("Root arc must be 0, 1 or 2")
+	ErrInvalidSecondArc  = errors.New("Second arc cannot exceed 39 beneath root arcs 0 and 1")
+	ErrInvalidLongArc    = errors.New("Long arcs must reside directly beneath joint-iso-itu-t(2)")
+	ErrUnsupportedType   = errors.New("Unsupported input type")
+	ErrNotDescendant     = errors.New("Value is not a descendant of the receiver")
+
+	ErrDuplicateIdentifier = errors.New("Duplicate identifier")
+	ErrIdentifierConflict  = errors.New("Identifier conflicts with that of a registered arc")
+	ErrInvalidLabel        = errors.New("Invalid Unicode label; characters must be drawn from the ITU-T Rec. X.660 repertoire")
+	ErrLabelMismatch       = errors.New("Integer Unicode label does not match NumberForm")
+	ErrDuplicateLabel      = errors.New("Duplicate Unicode label")
+	ErrLongArcLabel        = errors.New("Long arc requires a non-integer Unicode label")
+)
+
+/*
+ParseError describes a failure to parse an OID, or one of its components.
+
+Input is the offending input value. Where the input was a string, this is
+the entire string, otherwise it is the string representation of the
+offending component. Arc is the zero-based index of the offending arc, or
+-1 if not applicable. Offset is the zero-based byte offset within Input at
+which the problem was found, or -1 if not applicable. Kind is one of the
+exported parsing error values, such as [ErrLeadingZero].
+
+Errors returned by [DotNotation.Decode] may also be inspected as a
+*ParseError using [errors.As], in which case Input is the hexadecimal
+representation of the encoded octets and Offset is an octet offset.
+*/
+type ParseError struct {
+	Input  string
+	Arc    int
+	Offset int
+	Kind   error
+
+	component string // offending component, if not Input
+}
+
+/*
+Error returns the string representation of the receiver instance.
+*/
+func (r *ParseError) Error() (s string) {
+	if r.component != `` && r.component != r.Input {
+		s = sprintf("%s: '%s' in '%s'", r.Kind, r.component, r.Input)
+	} else {
+		s = sprintf("%s: '%s'", r.Kind, r.Input)
+	}
+	switch {
+	case r.Arc >= 0 && r.Offset >= 0:
+		s += sprintf(" (arc %d, offset %d)", r.Arc, r.Offset)
+	case r.Arc >= 0:
+		s += sprintf(" (arc %d)", r.Arc)
+	case r.Offset >= 0:
+		s += sprintf(" (offset %d)", r.Offset)
+	}
+
+	return
+}
+
+/*
+Unwrap returns the underlying error value within the receiver instance.
+*/
+func (r *ParseError) Unwrap() error {
+	return r.Kind
+}
+
+/*
+parseErr returns a new *[ParseError].
+*/
+func parseErr(kind error, input string, arc, offset int) error {
+	return &ParseError{Input: input, Arc: arc, Offset: offset, Kind: kind}
+}
+
+/*
+reparse returns err, relocated to the arc and offset of the component
+within a larger input. If err is not a *[ParseError], it is returned as
+is. A negative base offset leaves the component's Input and Offset
+intact.
+*/
+func reparse(err error, input string, arc, base int) error {
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return err
+	}
+
+	p := *pe
+	p.Arc = arc
+	if base >= 0 {
+		if p.component == `` {
+			p.component = p.Input
+		}
+		p.Input = input
+		if p.Offset >= 0 {
+			p.Offset += base
+		} else {
+			p.Offset = base
+		}
+	}
+
+	return &p
+}
+
+/*
+DecodeError describes a failure to decode an ASN.1 encoded OID. Offset
+is the zero-based index of the offending octet within the input, and Err
+is one of the exported decoding error values, such as [ErrTruncated].
+*/
+type DecodeError struct {
+	Offset int
+	Err    error
+	input  []byte
+}
+
+/*
+Error returns the string representation of the receiver instance.
+*/
+func (r *DecodeError) Error() string {
+	return sprintf("%s (offset %d)", r.Err, r.Offset)
+}
+
+/*
+Unwrap returns the underlying error value within the receiver instance.
+*/
+func (r *DecodeError) Unwrap() error {
+	return r.Err
+}
+
+/*
+As allows the receiver instance to be inspected as a *[ParseError] using
+[errors.As].
+*/
+func (r *DecodeError) As(target any) (ok bool) {
+	var pe **ParseError
+	if pe, ok = target.(**ParseError); ok {
+		*pe = &ParseError{
+			Input:  sprintf("%X", r.input),
+			Arc:    -1,
+			Offset: r.Offset,
+			Kind:   r.Err,
+		}
+	}
+
+	return
+}
+
+func decodeErr(err error, offset int) error {
+	return &DecodeError{Offset: offset, Err: err}
+}
+
+/*
+decodeInput records the encoded input b within err, if err is a
+*[DecodeError].
+*/
+func decodeInput(err error, b []byte) error {
+	var de *DecodeError
+	if errors.As(err, &de) {
+		de.input = b
+	}
+
+	return err
+}
@@ -0,0 +1,99 @@
+package objectid
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func ExampleParseError() {
+	_, err := NewDotNotation(`1.3.6.x.4`)
+
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		fmt.Printf("%v\narc:%d offset:%d invalid:%t",
+			err, pe.Arc, pe.Offset, errors.Is(err, ErrInvalidNumber))
+	}
+	// Output:
+	// NumberForm must consist solely of decimal digits: 'x' in '1.3.6.x.4' (arc 3, offset 6)
+	// arc:3 offset:6 invalid:true
+}
+
+func TestParseError(t *testing.T) {
+	reg := NewWellKnownRegistry()
+
+	for idx, test := range []struct {
+		fn     func() error
+		kind   error
+		input  string
+		arc    int
+		offset int
+	}{
+		{func() error { _, err := NewNumberForm(``); return err }, ErrEmpty, ``, -1, -1},
+		{func() error { _, err := NewNumberForm(`-1`); return err }, ErrNegative, `-1`, -1, 0},
+		{func() error { _, err := NewNumberForm(-1); return err }, ErrNegative, `-1`, -1, 0},
+		{func() error { _, err := NewNumberForm(big.NewInt(-5)); return err }, ErrNegative, `-5`, -1, 0},
+		{func() error { _, err := NewNumberForm(`12a`); return err }, ErrInvalidNumber, `12a`, -1, 2},
+		{func() error { _, err := NewNumberForm(`012`); return err }, ErrLeadingZero, `012`, -1, 0},
+		{func() error { _, err := NewNumberForm(1.5); return err }, ErrUnsupportedType, `float64`, -1, -1},
+
+		{func() error { _, err := NewDotNotation(``); return err }, ErrEmpty, ``, -1, -1},
+		{func() error { _, err := NewDotNotation(`1`); return err }, ErrTooFewArcs, `1`, -1, -1},
+		{func() error { _, err := NewDotNotation(`3.1`); return err }, ErrInvalidRoot, `3.1`, 0, 0},
+		{func() error { _, err := NewDotNotation(`1.40`); return err }, ErrInvalidSecondArc, `1.40`, 1, 2},
+		{func() error { _, err := NewDotNotation(`1.3..6`); return err }, ErrEmpty, `1.3..6`, 2, 4},
+		{func() error { _, err := NewDotNotation(`1.3.6.01`); return err }, ErrLeadingZero, `1.3.6.01`, 3, 6},
+		{func() error { _, err := NewDotNotation(1, 3, -6); return err }, ErrNegative, `-6`, 2, 0},
+		{func() error { _, err := NewDotNotation(1, 3, 6.1); return err }, ErrUnsupportedType, `float64`, 2, -1},
+
+		{func() error { _, err := NewNameAndNumberForm(`dod`); return err }, ErrUnresolvedArc, `dod`, -1, 0},
+		{func() error { _, err := NewNameAndNumberForm(`Dod(6)`); return err }, ErrInvalidIdentifier, `Dod(6)`, -1, 0},
+		{func() error { _, err := NewNameAndNumberForm(`dod6)`); return err }, ErrInvalidSyntax, `dod6)`, -1, 4},
+		{func() error { _, err := NewNameAndNumberForm(`dod(x)`); return err }, ErrInvalidNumber, `dod(x)`, -1, 4},
+		{func() error { _, err := NewNameAndNumberForm(`dod()`); return err }, ErrEmpty, `dod()`, -1, 4},
+
+		{func() error { _, err := NewOID(`{}`); return err }, ErrEmpty, `{}`, -1, -1},
+		{func() error { _, err := NewOID(`{iso(1) org(03)}`); return err }, ErrLeadingZero, `{iso(1) org(03)}`, 1, 12},
+		{func() error { _, err := NewOID(`{tree(3) x(1)}`); return err }, ErrInvalidRoot, `{tree(3) x(1)}`, 0, 1},
+		{func() error { _, err := NewOID(`{iso org(3) dod bogus}`, reg); return err }, ErrUnresolvedArc, `{iso org(3) dod bogus}`, 3, 16},
+		{func() error { _, err := NewOID(`{iso identified-organization(3) dod}`); return err }, ErrUnresolvedArc, `{iso identified-organization(3) dod}`, 2, 32},
+		{func() error { _, err := NewASN1Notation([]string{`iso`, `x_y(3)`}); return err }, ErrInvalidIdentifier, `iso x_y(3)`, 1, 4},
+		{func() error { _, err := NewASN1Notation(7); return err }, ErrUnsupportedType, `int`, -1, -1},
+
+		{func() error { _, err := NewRelativeOID(``); return err }, ErrEmpty, ``, -1, -1},
+		{func() error { _, err := NewRelativeOID(`4.1.x`); return err }, ErrInvalidNumber, `4.1.x`, 2, 4},
+		{func() error { _, err := NewRelativeOID(`4.01`); return err }, ErrLeadingZero, `4.01`, 1, 2},
+		{func() error { _, err := NewRelativeOID(4, -1); return err }, ErrNegative, `-1`, 1, 0},
+		{func() error { _, err := NewRelativeOID(4, 1.5); return err }, ErrUnsupportedType, `float64`, 1, -1},
+		{func() error { _, err := NewRelativeOID(); return err }, ErrEmpty, ``, -1, -1},
+		{func() error { _, err := mustDot(`1.3.6`).Relative(`1.3.7.1`); return err }, ErrNotDescendant, `1.3.7.1`, -1, -1},
+		{func() error { _, err := mustDot(`1.3.6`).Relative(`bogus`); return err }, ErrNotDescendant, `bogus`, -1, -1},
+	} {
+		err := test.fn()
+
+		var pe *ParseError
+		if !errors.Is(err, test.kind) {
+			t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, test.kind, err)
+		} else if !errors.As(err, &pe) {
+			t.Errorf("%s[%d] failed: %T is not a %T", t.Name(), idx, err, pe)
+		} else if pe.Input != test.input || pe.Arc != test.arc || pe.Offset != test.offset {
+			t.Errorf("%s[%d] failed: want (%q, arc %d, offset %d), got (%q, arc %d, offset %d)",
+				t.Name(), idx, test.input, test.arc, test.offset, pe.Input, pe.Arc, pe.Offset)
+		}
+	}
+}
+
+func TestDecodeError_parseError(t *testing.T) {
+	var d DotNotation
+	err := d.Decode([]byte{0x06, 0x03, 0x2B, 0x86, 0x86}, Strict)
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Errorf("%s failed: %T is not a %T", t.Name(), err, pe)
+	} else if pe.Input != `06032B8686` || pe.Offset != 4 || pe.Arc != -1 {
+		t.Errorf("%s failed: unexpected %T: %#v", t.Name(), pe, pe)
+	} else if !errors.Is(err, ErrTruncatedSubidentifier) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrTruncatedSubidentifier, err)
+	}
+}
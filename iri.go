@@ -0,0 +1,326 @@
+package objectid
+
+/*
+iri.go handles OID-IRI operations, per ITU-T Rec. X.680 clause 34 and
+ITU-T Rec. X.660.
+*/
+
+import "unicode/utf8"
+
+/*
+IRINotation contains an ordered sequence of Unicode labels, each of which
+identifies an arc of the OID-IRI. A label is either an integer label (e.g.:
+"6"), or a non-integer label (e.g.: "Joint-ISO-ITU-T").
+*/
+type IRINotation []string
+
+/*
+LabelSource is a source of Unicode labels, such as a registry, consulted
+during the conversion of [IRINotation] instances to and from [DotNotation]
+instances.
+
+Label returns the preferred non-integer Unicode label for the final arc
+of the input [DotNotation], alongside a Boolean value indicative of success.
+
+Resolve returns the [DotNotation] of the arc bearing the input non-integer
+Unicode label beneath the input parent [DotNotation], alongside a Boolean
+value indicative of success. A zero parent indicates the root. In support
+of long arcs, the return value may extend beyond the parent by more than
+one (1) arc (e.g.: "Example" resolving to 2.999 from the root).
+*/
+type LabelSource interface {
+	Label(DotNotation) (string, bool)
+	Resolve(DotNotation, string) (DotNotation, bool)
+}
+
+/*
+iriRoots contains the non-integer Unicode labels of the root arcs.
+*/
+var iriRoots []string = []string{`ITU-T`, `ISO`, `Joint-ISO-ITU-T`}
+
+/*
+String is a stringer method that returns the OID-IRI form of the receiver
+(e.g.: "/ISO/Identified-Organization/6").
+*/
+func (r IRINotation) String() (s string) {
+	if r.Len() > 0 {
+		s = `/` + join(r, `/`)
+	}
+	return
+}
+
+/*
+Len returns the integer length of the receiver.
+*/
+func (r IRINotation) Len() int {
+	return len(r)
+}
+
+/*
+IsZero returns a Boolean indicative of whether the receiver is unset.
+*/
+func (r IRINotation) IsZero() bool {
+	return r.Len() == 0
+}
+
+/*
+Valid returns a Boolean value indicative of whether the receiver contains
+one (1) or more arcs, each of which is a valid integer or non-integer
+Unicode label.
+*/
+func (r IRINotation) Valid() bool {
+	if r.IsZero() {
+		return false
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		if !isIntegerLabel(r[i]) && !isNonIntegerLabel(r[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+NewIRINotation returns an instance of *[IRINotation] alongside an error
+following an attempt to parse x, which must be an OID-IRI string value
+(e.g.: "/ISO/Identified-Organization/6").
+*/
+func NewIRINotation(x string) (r *IRINotation, err error) {
+	x = trimS(x)
+	if len(x) < 2 || x[0] != '/' {
+		err = errorf("Invalid OID-IRI '%s'; must begin with '/'", x)
+		return
+	}
+
+	labels := split(x[1:], `/`)
+	for i := 0; i < len(labels); i++ {
+		if !isIntegerLabel(labels[i]) && !isNonIntegerLabel(labels[i]) {
+			err = errorf("Invalid Unicode label '%s' at OID-IRI arc %d", labels[i], i)
+			return
+		}
+	}
+
+	r = new(IRINotation)
+	*r = IRINotation(labels)
+
+	return
+}
+
+/*
+Dot returns an instance of *[DotNotation] alongside an error following an
+attempt to resolve the receiver's arcs. Integer labels are used verbatim,
+while non-integer labels are resolved through src. The non-integer labels
+of the root arcs ("ITU-T", "ISO" and "Joint-ISO-ITU-T") are always known,
+thus src may be nil for OID-IRIs which contain no other such labels.
+*/
+func (r IRINotation) Dot(src LabelSource) (dot *DotNotation, err error) {
+	var D DotNotation
+	if D, _, err = r.resolve(src); err == nil {
+		dot = &D
+	}
+
+	return
+}
+
+/*
+ASN returns an instance of *[ASN1Notation] alongside an error following
+an attempt to resolve the receiver's arcs through src. Each arc bearing
+a non-integer label which also qualifies as an ASN.1 identifier (e.g.:
+"example") is assigned that identifier. All other arcs, including those
+spanned by a long arc, bear only their respective [NumberForm] value.
+*/
+func (r IRINotation) ASN(src LabelSource) (asn *ASN1Notation, err error) {
+	var (
+		D    DotNotation
+		ends []int
+	)
+	if D, ends, err = r.resolve(src); err != nil {
+		return
+	}
+
+	A := make(ASN1Notation, D.Len())
+	for i := 0; i < D.Len(); i++ {
+		A[i] = NameAndNumberForm{primaryIdentifier: D[i], parsed: true}
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		if isIdentifier(r[i]) {
+			A[ends[i]-1].identifier = r[i]
+		}
+	}
+	asn = &A
+
+	return
+}
+
+/*
+resolve returns the [DotNotation] resolved from the receiver's arcs, along
+with the length of the [DotNotation] following the resolution of each arc
+and an error.
+*/
+func (r IRINotation) resolve(src LabelSource) (D DotNotation, ends []int, err error) {
+	if !r.Valid() {
+		err = errorf("Invalid %T '%s'", r, r)
+		return
+	}
+
+	D = make(DotNotation, 0, r.Len())
+	ends = make([]int, r.Len())
+	for i := 0; i < r.Len() && err == nil; i++ {
+		var nf NumberForm
+		if isIntegerLabel(r[i]) {
+			if nf, err = NewNumberForm(r[i]); err == nil {
+				D = append(D, nf)
+			}
+		} else if idx := strIndex(r[i], iriRoots); idx != -1 && i == 0 {
+			nf, _ = NewNumberForm(idx)
+			D = append(D, nf)
+		} else {
+			D, err = resolveLabel(D, r[i], src)
+		}
+		ends[i] = D.Len()
+	}
+
+	if err == nil && !D.Valid() {
+		err = errorf("OID-IRI '%s' did not resolve to a valid %T", r, D)
+	}
+
+	return
+}
+
+func resolveLabel(parent DotNotation, label string, src LabelSource) (D DotNotation, err error) {
+	if src != nil {
+		var ok bool
+		if D, ok = src.Resolve(parent, label); ok {
+			if D.Len() > parent.Len() && (parent.IsZero() || parent.AncestorOf(D)) {
+				return
+			}
+		}
+	}
+
+	err = errorf("Unable to resolve Unicode label '%s' beneath '/%s'", label, parent)
+
+	return
+}
+
+/*
+IRI returns an instance of [IRINotation] based upon the receiver. Each arc
+is rendered using the non-integer label returned by src, if available, or
+as an integer label otherwise. The root arc is always rendered using its
+well-known non-integer label. src may be nil.
+*/
+func (r DotNotation) IRI(src LabelSource) (iri IRINotation) {
+	if !r.Valid() {
+		return
+	}
+
+	iri = make(IRINotation, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		iri[i] = r[i].String()
+		if src != nil {
+			if label, ok := src.Label(r[:i+1]); ok && isNonIntegerLabel(label) {
+				iri[i] = label
+				continue
+			}
+		}
+
+		if i == 0 {
+			iri[i] = iriRoots[int(r[i].cast().Uint64())]
+		}
+	}
+
+	return
+}
+
+/*
+IRI returns an instance of [IRINotation] based upon the receiver. See the
+[DotNotation.IRI] method for details.
+
+Any non-integer Unicode label assigned to a [NameAndNumberForm] within the
+receiver takes precedence over src. If the receiver contains a long arc,
+the return value begins at the (final) long arc.
+*/
+func (r ASN1Notation) IRI(src LabelSource) (iri IRINotation) {
+	if iri = r.Dot().IRI(src); iri.IsZero() {
+		return
+	}
+
+	var start int
+	for i := 0; i < r.Len(); i++ {
+		if label, ok := r[i].nonIntegerLabel(); ok {
+			iri[i] = label
+			if r[i].longArc {
+				start = i
+			}
+		}
+	}
+	iri = iri[start:]
+
+	return
+}
+
+/*
+isIntegerLabel returns a Boolean value indicative of whether val qualifies
+as an integer Unicode label, in that it is comprised solely of digits and
+bears no leading zeros (unless it is zero).
+*/
+func isIntegerLabel(val string) bool {
+	if !isNumber(val) {
+		return false
+	}
+	return len(val) == 1 || val[0] != '0'
+}
+
+/*
+isNonIntegerLabel returns a Boolean value indicative of whether val
+qualifies as a non-integer Unicode label, in that:
+
+  - It is non-zero in length, and is not comprised solely of digits
+  - It contains only characters permitted within iunreserved (RFC 3987)
+  - It neither begins nor ends with a hyphen
+  - It does not contain hyphens in both the third and fourth positions
+*/
+func isNonIntegerLabel(val string) bool {
+	if len(val) == 0 || isNumber(val) || !utf8.ValidString(val) {
+		return false
+	}
+
+	if val[0] == '-' || val[len(val)-1] == '-' {
+		return false
+	}
+
+	var pos int
+	var hyphens int
+	for _, ch := range val {
+		if !isIUnreserved(ch) {
+			return false
+		}
+		if pos++; (pos == 3 || pos == 4) && ch == '-' {
+			hyphens++
+		}
+	}
+
+	return hyphens < 2
+}
+
+/*
+isIUnreserved returns a Boolean value indicative of whether ch is an
+iunreserved character, per RFC 3987 Section 2.2.
+*/
+func isIUnreserved(ch rune) bool {
+	switch {
+	case ch < 0x80:
+		return isAlnum(ch) || ch == '-' || ch == '.' || ch == '_' || ch == '~'
+	case 0xA0 <= ch && ch <= 0xD7FF,
+		0xF900 <= ch && ch <= 0xFDCF,
+		0xFDF0 <= ch && ch <= 0xFFEF:
+		return true
+	case 0x10000 <= ch && ch <= 0xEFFFD:
+		// exclude the noncharacters at the end of each plane
+		return ch&0xFFFF <= 0xFFFD
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+/*
+testLabels is a trivial [LabelSource] used for testing, mapping
+dot notation strings to their respective non-integer labels.
+*/
+type testLabels map[string]string
+
+func (r testLabels) Label(dot DotNotation) (label string, ok bool) {
+	label, ok = r[dot.String()]
+	return
+}
+
+func (r testLabels) Resolve(parent DotNotation, label string) (dot DotNotation, ok bool) {
+	for k, v := range r {
+		if v != label {
+			continue
+		}
+		D, _ := NewDotNotation(k)
+		if D == nil {
+			// single arc key, such as a root
+			continue
+		}
+		if (parent.IsZero() && D.Len() >= 2) || parent.ChildOf(D) ||
+			(parent.Len() == 1 && D.Len() > 1 && parent.Root().Equal(D.Root())) {
+			return *D, true
+		}
+	}
+	return
+}
+
+var iriLabels testLabels = testLabels{
+	`1.3`:     `Identified-Organization`,
+	`1.3.6`:   `dod`,
+	`2.999`:   `Example`,
+	`2.999.1`: `Ünïcödé`,
+	`2.25`:    `UUID`,
+}
+
+func ExampleNewIRINotation() {
+	iri, err := NewIRINotation(`/ISO/Identified-Organization/6/1`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	dot, err := iri.Dot(iriLabels)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", dot)
+	// Output: 1.3.6.1
+}
+
+func ExampleDotNotation_IRI() {
+	dot, _ := NewDotNotation(`2.999.1.4`)
+	fmt.Printf("%s", dot.IRI(iriLabels))
+	// Output: /Joint-ISO-ITU-T/Example/Ünïcödé/4
+}
+
+func ExampleIRINotation_ASN() {
+	iri, _ := NewIRINotation(`/ISO/Identified-Organization/dod/1`)
+	asn, err := iri.ASN(iriLabels)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", asn)
+	// Output: {1 3 dod(6) 1}
+}
+
+func TestIRINotation_longArc(t *testing.T) {
+	for _, raw := range []string{
+		`/Example/Ünïcödé`,
+		`/Joint-ISO-ITU-T/Example/Ünïcödé`,
+		`/2/999/1`,
+	} {
+		iri, err := NewIRINotation(raw)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		}
+
+		var dot *DotNotation
+		if dot, err = iri.Dot(iriLabels); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != `2.999.1` {
+			t.Errorf("%s failed: want '2.999.1', got '%s'", t.Name(), dot)
+		} else if got := iri.String(); got != raw {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), raw, got)
+		}
+	}
+}
+
+func TestIRINotation_labels(t *testing.T) {
+	for label, valid := range map[string]bool{
+		`0`:             true,
+		`56521`:         true,
+		`007`:           false,
+		`Example`:       true,
+		`a_b.c~d`:       true,
+		`Ünïcödé`:       true,
+		`-leading`:      false,
+		`trailing-`:     false,
+		`ab--cd`:        false,
+		`a--b`:          true,
+		`has space`:     false,
+		`percent%20`:    false,
+		``:              false,
+		"\uFFFE":        false,
+		"\U0001F600emo": true,
+	} {
+		if got := isIntegerLabel(label) || isNonIntegerLabel(label); got != valid {
+			t.Errorf("%s failed: %q want %t, got %t", t.Name(), label, valid, got)
+		}
+	}
+}
+
+func TestIRINotation_codecov(t *testing.T) {
+	for _, bogus := range []string{``, `/`, `ISO/1`, `/ISO//1`, `/ISO/01`} {
+		if _, err := NewIRINotation(bogus); err == nil {
+			t.Errorf("%s failed: bogus OID-IRI '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+
+	iri, _ := NewIRINotation(`/ISO/Unknown/1`)
+	if _, err := iri.Dot(nil); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+	if _, err := iri.ASN(iriLabels); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	iri, _ = NewIRINotation(`/ISO`)
+	if _, err := iri.Dot(nil); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	var zero IRINotation
+	if zero.Valid() || zero.String() != `` {
+		t.Errorf("%s failed: zero %T considered valid", t.Name(), zero)
+	}
+
+	asn, _ := NewASN1Notation(`{joint-iso-itu-t(2) uuid(25) 1}`)
+	if got := asn.IRI(iriLabels).String(); got != `/Joint-ISO-ITU-T/UUID/1` {
+		t.Errorf("%s failed: unexpected OID-IRI '%s'", t.Name(), got)
+	}
+
+	var dot DotNotation
+	if !dot.IRI(nil).IsZero() {
+		t.Errorf("%s failed: expected zero %T", t.Name(), dot.IRI(nil))
+	}
+}
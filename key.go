@@ -0,0 +1,115 @@
+package objectid
+
+/*
+key.go implements a comparable OID representation for use as a map key.
+*/
+
+/*
+Key is a compact, comparable representation of an OID, suitable for use
+as a map key or set member. It is comprised of the DER content octets of
+the OID, thus two (2) instances of Key are equal if, and only if, the OIDs
+they represent are equal.
+
+Instances of this type are obtained using the [DotNotation.Key], [OID.Key]
+and [Decoder.Key] methods. The zero value represents no OID.
+*/
+type Key struct {
+	content string // DER content octets
+}
+
+/*
+Key returns the [Key] representation of the receiver instance alongside
+an error. An error is returned if the receiver cannot be encoded, such as
+when fewer than two (2) arcs are present.
+*/
+func (r DotNotation) Key() (k Key, err error) {
+	var b []byte
+	if b, err = r.encodeContent(); err == nil {
+		k = Key{content: string(b)}
+	}
+
+	return
+}
+
+/*
+Key returns the [Key] representation of the receiver instance alongside
+an error. See [DotNotation.Key] for details.
+*/
+func (r OID) Key() (Key, error) {
+	return r.Dot().Key()
+}
+
+/*
+Key returns the [Key] representation of the current OID.
+*/
+func (r *Decoder) Key() (k Key) {
+	if r.content == nil {
+		return
+	}
+
+	// padded subidentifiers, which are only
+	// permitted absent MinimalSubidentifiers,
+	// must be discarded for the key to remain
+	// canonical.
+	if isMinimalContent(r.content) {
+		k = Key{content: string(r.content)}
+	} else {
+		k, _ = r.DotNotation(nil).Key()
+	}
+
+	return
+}
+
+/*
+IsZero returns a Boolean value indicative of whether the receiver is
+unset.
+*/
+func (r Key) IsZero() bool {
+	return len(r.content) == 0
+}
+
+/*
+Dot returns the [DotNotation] represented by the receiver instance. A zero
+receiver instance yields a zero [DotNotation].
+*/
+func (r Key) Dot() (d DotNotation) {
+	if !r.IsZero() {
+		// the content octets were produced by
+		// encodeContent or verified by Decoder.
+		d, _ = decodeSubidentifiers([]byte(r.content), 0, false, true)
+	}
+
+	return
+}
+
+/*
+String returns the dot notation form of the receiver instance (e.g.:
+"1.3.6.1.4.1.56521").
+*/
+func (r Key) String() string {
+	return r.Dot().String()
+}
+
+/*
+Bytes returns the DER content octets of the receiver instance, absent the
+identifier (tag) and length octets. See [DotNotation.DecodeContent].
+*/
+func (r Key) Bytes() []byte {
+	return []byte(r.content)
+}
+
+/*
+isMinimalContent returns a Boolean value indicative of whether no
+subidentifier within the content octets b begins with a 0x80 octet.
+*/
+func isMinimalContent(b []byte) bool {
+	start := true
+	for i := 0; i < len(b); i++ {
+		if start && b[i] == 0x80 {
+			return false
+		}
+		start = b[i]&0x80 == 0
+	}
+
+	return true
+}
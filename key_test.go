@@ -0,0 +1,95 @@
+package objectid
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleDotNotation_Key() {
+	seen := make(map[Key]int)
+	for _, x := range []string{
+		`1.3.6.1.4.1.56521`,
+		`2.25.329800735698586629295641978511506172918`,
+		`1.3.6.1.4.1.56521`,
+	} {
+		dot, _ := NewDotNotation(x)
+		key, _ := dot.Key()
+		seen[key]++
+	}
+
+	key, _ := mustDot(`1.3.6.1.4.1.56521`).Key()
+	fmt.Println(len(seen), seen[key], key)
+	// Output: 2 2 1.3.6.1.4.1.56521
+}
+
+func TestKey(t *testing.T) {
+	for _, x := range []string{
+		`0.0`,
+		`1.3.6.1.4.1.56521.999.5`,
+		`2.999.18446744073709551616`,
+		`2.25.329800735698586629295641978511506172918`,
+	} {
+		dot := mustDot(x)
+		key, err := dot.Key()
+		if err != nil {
+			t.Errorf("%s failed [%s]: %v", t.Name(), x, err)
+			continue
+		}
+
+		content, _ := dot.EncodeContent()
+		if !bytes.Equal(key.Bytes(), content) {
+			t.Errorf("%s failed [%s]: want content %x, got %x", t.Name(), x, content, key.Bytes())
+		} else if key.String() != x || Compare(key.Dot(), dot) != 0 {
+			t.Errorf("%s failed [%s]: reconstruction yielded %s", t.Name(), x, key)
+		}
+
+		oid, err := NewOID(`{` + strings.ReplaceAll(x, `.`, ` `) + `}`)
+		if err != nil {
+			t.Errorf("%s failed [%s]: %v", t.Name(), x, err)
+			continue
+		}
+
+		if k, _ := oid.Key(); k != key {
+			t.Errorf("%s failed [%s]: OID key %s does not match", t.Name(), x, k)
+		}
+	}
+
+	var zero Key
+	if !zero.IsZero() || zero.Dot() != nil || zero.String() != `` {
+		t.Errorf("%s failed: unexpected zero value behavior", t.Name())
+	}
+
+	if _, err := (DotNotation{}).Key(); err == nil {
+		t.Errorf("%s failed: expected error for zero length input", t.Name())
+	} else if _, err = (OID{}).Key(); err == nil {
+		t.Errorf("%s failed: expected error for zero OID", t.Name())
+	}
+}
+
+func TestDecoder_Key(t *testing.T) {
+	want, _ := mustDot(`1.3.6.1`).Key()
+
+	// the second encoding pads a subidentifier,
+	// which is permitted absent Strict decoding.
+	dec := NewDecoder([]byte{
+		0x06, 0x03, 0x2B, 0x06, 0x01,
+		0x06, 0x04, 0x2B, 0x80, 0x06, 0x01,
+	})
+
+	if !dec.Key().IsZero() {
+		t.Errorf("%s failed: non-zero key prior to Next", t.Name())
+	}
+
+	var n int
+	for dec.Next() {
+		if n++; dec.Key() != want {
+			t.Errorf("%s failed [%d]: want key %s, got %s", t.Name(), n, want, dec.Key())
+		}
+	}
+
+	if n != 2 || dec.Err() != nil {
+		t.Errorf("%s failed: want 2 OIDs, got %d (%v)", t.Name(), n, dec.Err())
+	}
+}
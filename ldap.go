@@ -0,0 +1,94 @@
+package objectid
+
+/*
+ldap.go implements the LDAP profile of object identifiers, per RFC 4512
+section 1.4.
+*/
+
+/*
+ParseNumericOID returns a *[DotNotation] alongside an error following an
+attempt to parse the input string value as an RFC 4512 numericoid:
+
+	numericoid = number 1*( DOT number )
+	number     = DIGIT / ( LDIGIT 1*DIGIT )
+
+Thus, at least two (2) arcs are required, and no arc may bear a leading
+zero (e.g.: "1.03.6" is invalid). Note that, unlike [NewDotNotation], the
+magnitude of the root arc is not constrained, as RFC 4512 imposes no such
+limit; use [DotNotation.Valid] where ITU-T Rec. X.660 conformance is also
+required.
+*/
+func ParseNumericOID(x string) (r *DotNotation, err error) {
+	arcs := split(x, `.`)
+	if len(arcs) < 2 {
+		err = errorf("Invalid numericoid '%s': at least two (2) arcs are required", x)
+		return
+	}
+
+	D := make(DotNotation, len(arcs))
+	for i, arc := range arcs {
+		if !isCanonicalNumber(arc) {
+			err = errorf("Invalid numericoid '%s': arc %d ('%s') is not an RFC 4512 number", x, i, arc)
+			return
+		}
+		if D[i], err = NewNumberForm(arc); err != nil {
+			return
+		}
+	}
+	r = &D
+
+	return
+}
+
+/*
+ParseDescr returns the input string value alongside an error following
+an attempt to verify it as an RFC 4512 descr (short name):
+
+	descr       = keystring
+	keystring   = leadkeychar *keychar
+	leadkeychar = ALPHA
+	keychar     = ALPHA / DIGIT / HYPHEN
+
+Unlike an ASN.1 identifier (see [IsIdentifier]), a descr may begin with an
+uppercase letter, and may end with -- or contain consecutive -- hyphens.
+Note that descr values are compared case-insensitively.
+*/
+func ParseDescr(x string) (descr string, err error) {
+	if !isKeystring(x) {
+		err = errorf("Invalid descr '%s': not an RFC 4512 keystring", x)
+		return
+	}
+	descr = x
+
+	return
+}
+
+/*
+ParseOIDOrDescr returns either a *[DotNotation] or a descr string value
+alongside an error following an attempt to parse the input string value
+as an RFC 4512 oid:
+
+	oid = descr / numericoid
+
+Exactly one (1) of the two (2) return values shall be set upon success.
+*/
+func ParseOIDOrDescr(x string) (dot *DotNotation, descr string, err error) {
+	return oidOrDescr(x)
+}
+
+/*
+oidOrDescr distinguishes numericoid and descr forms by their leading
+character, which is a DIGIT or an ALPHA respectively.
+*/
+func oidOrDescr(x string) (dot *DotNotation, descr string, err error) {
+	switch {
+	case len(x) == 0:
+		err = errorf("Invalid oid: zero length")
+	case '0' <= x[0] && x[0] <= '9':
+		dot, err = ParseNumericOID(x)
+	default:
+		descr, err = ParseDescr(x)
+	}
+
+	return
+}
@@ -0,0 +1,71 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleParseNumericOID() {
+	dot, err := ParseNumericOID(`1.3.6.1.4.1.56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(dot)
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func ExampleParseOIDOrDescr() {
+	for _, oid := range []string{`2.5.4.3`, `commonName`} {
+		dot, descr, err := ParseOIDOrDescr(oid)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if dot != nil {
+			fmt.Printf("numericoid: %s\n", dot)
+		} else {
+			fmt.Printf("descr: %s\n", descr)
+		}
+	}
+	// Output:
+	// numericoid: 2.5.4.3
+	// descr: commonName
+}
+
+func TestParseNumericOID(t *testing.T) {
+	for _, valid := range []string{`0.0`, `1.3.6.1`, `2.25.329800735698586629295641978511506172918`, `3.1`} {
+		if _, err := ParseNumericOID(valid); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	for _, bogus := range []string{``, `1`, `1.03.6`, `01.3`, `1..3`, `1.3.`, `.1.3`, `1.-3`, `1.3a`, `1.3 `} {
+		if _, err := ParseNumericOID(bogus); err == nil {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bogus)
+		}
+	}
+}
+
+func TestParseDescr(t *testing.T) {
+	for _, valid := range []string{`cn`, `CN`, `x-trailing-`, `a--b`, `pkcs9email`, `A1`} {
+		if _, err := ParseDescr(valid); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		}
+	}
+
+	for _, bogus := range []string{``, `1cn`, `-cn`, `c_n`, `cn;binary`, `cn.1`, `ñame`} {
+		if _, err := ParseDescr(bogus); err == nil {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bogus)
+		}
+	}
+}
+
+func TestParseOIDOrDescr(t *testing.T) {
+	for _, bogus := range []string{``, `1.03`, `-x`} {
+		if dot, descr, err := ParseOIDOrDescr(bogus); err == nil || dot != nil || descr != `` {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bogus)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package objectid
+
+/*
+macro.go implements OpenLDAP objectIdentifier macro expansion, as used
+within slapd.conf(5) and slapd-config(5) schema definitions.
+*/
+
+import (
+	"bufio"
+	"io"
+)
+
+/*
+MacroTable contains OpenLDAP objectIdentifier macros, each of which maps
+a name to a [DotNotation] value. Instances of this type should only be
+initialized using the [NewMacroTable] function.
+
+Macro names are case-insensitive, as is the case for LDAP descriptors.
+*/
+type MacroTable struct {
+	names  []string // order of definition
+	macros map[string]macro
+}
+
+type macro struct {
+	name string
+	dot  DotNotation
+}
+
+/*
+NewMacroTable returns a freshly initialized instance of *[MacroTable].
+*/
+func NewMacroTable() *MacroTable {
+	return &MacroTable{macros: make(map[string]macro)}
+}
+
+/*
+Len returns the integer number of macros defined within the receiver.
+*/
+func (r *MacroTable) Len() int {
+	return len(r.names)
+}
+
+/*
+Set returns an error following an attempt to define a macro within the
+receiver. The name must be a valid LDAP descriptor (e.g.: "myOID"), and
+the value may be a numeric OID (e.g.: "1.3.6.1.4.1.56521") or a reference
+to a previously defined macro (e.g.: "myOID:1.2").
+
+Redefinition of a macro is not permitted.
+*/
+func (r *MacroTable) Set(name, value string) (err error) {
+	if !isKeystring(name) {
+		err = errorf("Invalid objectIdentifier macro name '%s'", name)
+		return
+	}
+
+	key := lc(name)
+	if m, exists := r.macros[key]; exists {
+		err = errorf("Duplicate objectIdentifier macro '%s' (already defined as %s)", name, m.dot)
+		return
+	}
+
+	var d *DotNotation
+	if d, err = r.Expand(value); err == nil {
+		r.macros[key] = macro{name: name, dot: *d}
+		r.names = append(r.names, key)
+	}
+
+	return
+}
+
+/*
+Lookup returns the [DotNotation] value of the named macro alongside a
+Boolean value indicative of success.
+*/
+func (r *MacroTable) Lookup(name string) (d DotNotation, ok bool) {
+	var m macro
+	if m, ok = r.macros[lc(name)]; ok {
+		d = m.dot
+	}
+
+	return
+}
+
+/*
+Load returns an error following an attempt to read objectIdentifier
+directives from src. Both the slapd.conf(5) and slapd-config(5) (LDIF)
+forms are supported:
+
+	objectIdentifier myOID 1.3.6.1.4.1.56521
+	objectidentifier myAttrs myOID:1
+	olcObjectIdentifier: myClasses myOID:2
+
+All other lines, including comments and other directives, are ignored.
+The error returned, if any, bears the line number of the offending
+directive.
+
+Continuation lines are joined according to the format of the directive
+being read. For slapd.conf(5), a line beginning with whitespace continues
+the preceding line, and the two are joined by a single space. For LDIF,
+folded lines are unfolded per RFC 2849 section 2: the line break and the
+single leading space are removed, and nothing is inserted. The X-ORDERED
+prefix of an LDIF value (e.g.: "{0}myOID") is discarded.
+*/
+func (r *MacroTable) Load(src io.Reader) (err error) {
+	var (
+		line, start int
+		directive   string
+		ldif        bool // whether directive is an LDIF attribute line
+	)
+
+	flush := func() error {
+		if directive == `` {
+			return nil
+		}
+		defer func() { directive = `` }()
+
+		var f []string
+		if ldif {
+			attr, value, _ := cut(directive, `:`)
+			if !eq(attr, `olcObjectIdentifier`) {
+				return nil
+			}
+			if f = append([]string{attr}, fields(value)...); len(f) > 1 {
+				f[1] = trimOrdered(f[1])
+			}
+		} else if f = fields(directive); !eq(f[0], `objectIdentifier`) {
+			return nil
+		}
+
+		if len(f) != 3 {
+			return errorf("Line %d: objectIdentifier requires a name and value: %s", start, directive)
+		} else if err := r.Set(f[1], f[2]); err != nil {
+			return errorf("Line %d: %v", start, err)
+		}
+
+		return nil
+	}
+
+	scanner := bufio.NewScanner(src)
+	for scanner.Scan() && err == nil {
+		line++
+		text := scanner.Text()
+		switch {
+		case len(trimS(text)) == 0, hasPrefix(text, `#`):
+			err = flush()
+		case text[0] == ' ' || text[0] == '\t':
+			switch {
+			case directive == ``:
+			case ldif:
+				directive += text[1:]
+			default:
+				directive += ` ` + trimS(text)
+			}
+		default:
+			if err = flush(); err == nil {
+				// an LDIF attribute line bears a colon
+				// within its first token, and must not
+				// be trimmed, lest a space preceding a
+				// fold be lost.
+				ldif = indexRune(fields(text)[0], ':') >= 0
+				if directive, start = text, line; !ldif {
+					directive = trimS(text)
+				}
+			}
+		}
+	}
+
+	if err == nil {
+		if err = scanner.Err(); err == nil {
+			err = flush()
+		}
+	}
+
+	return
+}
+
+/*
+trimOrdered returns val absent any leading X-ORDERED index prefix, such
+as "{0}", as used by slapd-config(5).
+*/
+func trimOrdered(val string) string {
+	if end := indexRune(val, '}'); end > 1 && val[0] == '{' && isNumber(val[1:end]) {
+		val = val[end+1:]
+	}
+
+	return val
+}
+
+/*
+Expand returns a *[DotNotation] alongside an error following an attempt
+to expand the input value, which may be any of the following:
+
+  - a numeric OID (e.g.: "1.3.6.1.4.1.56521")
+  - a macro name (e.g.: "myOID")
+  - a macro name followed by a colon and numeric suffix (e.g.: "myOID:1.2")
+
+As macro names cannot begin with a digit, any value which does so is parsed
+as a numeric OID; if malformed (e.g.: "1.3.x"), the *[ParseError] returned
+by [NewDotNotation] is returned.
+*/
+func (r *MacroTable) Expand(value string) (d *DotNotation, err error) {
+	name, suffix, colon := cut(value, `:`)
+	if len(name) > 0 && '0' <= name[0] && name[0] <= '9' {
+		d, err = NewDotNotation(value)
+		return
+	}
+
+	m, ok := r.macros[lc(name)]
+	if !ok {
+		err = errorf("Undefined objectIdentifier macro '%s' in '%s'", name, value)
+		return
+	}
+
+	D := append(DotNotation{}, m.dot...)
+	if colon {
+		if suffix == `` {
+			err = errorf("Missing suffix for objectIdentifier macro in '%s'", value)
+			return
+		}
+
+		for _, arc := range split(suffix, `.`) {
+			var nf NumberForm
+			if !isNumber(arc) {
+				err = errorf("Invalid suffix '%s' for objectIdentifier macro in '%s'", suffix, value)
+				return
+			} else if nf, err = NewNumberForm(arc); err != nil {
+				return
+			}
+			D = append(D, nf)
+		}
+	}
+	d = &D
+
+	return
+}
+
+/*
+Shortest returns the shortest macro form of the input [DotNotation]
+alongside a Boolean value indicative of whether a macro was used. If
+no macro is an ancestor of (or equal to) the input value, the numeric
+OID is returned instead.
+
+Where two (2) or more macros yield forms of equal length, the deepest
+macro is preferred, followed by the macro defined first.
+*/
+func (r *MacroTable) Shortest(dot DotNotation) (s string, ok bool) {
+	s = dot.String()
+
+	var best *macro
+	var bestLen int
+	for _, key := range r.names {
+		m := r.macros[key]
+		if m.dot.Len() > dot.Len() || Compare(m.dot, dot[:m.dot.Len()]) != 0 {
+			continue
+		}
+
+		form := macroForm(m, dot)
+		switch {
+		case best == nil,
+			len(form) < bestLen,
+			len(form) == bestLen && m.dot.Len() > best.dot.Len():
+			best, bestLen = &m, len(form)
+		}
+	}
+
+	if best != nil {
+		s, ok = macroForm(*best, dot), true
+	}
+
+	return
+}
+
+/*
+Names returns the names of all macros within the receiver, in order of
+definition.
+*/
+func (r *MacroTable) Names() (names []string) {
+	for _, key := range r.names {
+		names = append(names, r.macros[key].name)
+	}
+
+	return
+}
+
+/*
+macroForm returns the macro form of dot relative to ancestor m.
+*/
+func macroForm(m macro, dot DotNotation) string {
+	if dot.Len() == m.dot.Len() {
+		return m.name
+	}
+
+	return m.name + `:` + dot[m.dot.Len():].String()
+}
@@ -0,0 +1,167 @@
+package objectid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const testSlapdSchema = `# example schema
+objectIdentifier myOID 1.3.6.1.4.1.56521
+objectIdentifier myLDAP myOID:101
+ObjectIdentifier myAttrs
+	myLDAP:2
+objectidentifier myClasses myLDAP:3
+
+attributetype ( myAttrs:1 NAME 'exampleAttr'
+	SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )
+
+dn: cn=example,cn=schema,cn=config
+olcObjectIdentifier: myControls myLDAP:4
+`
+
+func ExampleMacroTable_Expand() {
+	macros := NewMacroTable()
+	if err := macros.Load(strings.NewReader(testSlapdSchema)); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	d, err := macros.Expand(`myAttrs:1`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(d)
+	// Output: 1.3.6.1.4.1.56521.101.2.1
+}
+
+func ExampleMacroTable_Shortest() {
+	macros := NewMacroTable()
+	_ = macros.Set(`myOID`, `1.3.6.1.4.1.56521`)
+	_ = macros.Set(`myClasses`, `myOID:101.3`)
+
+	d, _ := NewDotNotation(`1.3.6.1.4.1.56521.101.3.7`)
+	s, _ := macros.Shortest(*d)
+	fmt.Println(s)
+	// Output: myClasses:7
+}
+
+func TestMacroTable(t *testing.T) {
+	macros := NewMacroTable()
+	if err := macros.Load(strings.NewReader(testSlapdSchema)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if got := join(macros.Names(), ` `); got != `myOID myLDAP myAttrs myClasses myControls` {
+		t.Errorf("%s failed: unexpected macros: %s", t.Name(), got)
+	}
+
+	for in, want := range map[string]string{
+		`myOID`:            `1.3.6.1.4.1.56521`,
+		`MYLDAP`:           `1.3.6.1.4.1.56521.101`,
+		`myClasses:1`:      `1.3.6.1.4.1.56521.101.3.1`,
+		`myControls:1.2.3`: `1.3.6.1.4.1.56521.101.4.1.2.3`,
+		`2.5.4.3`:          `2.5.4.3`,
+	} {
+		if d, err := macros.Expand(in); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if d.String() != want {
+			t.Errorf("%s failed: %s: want %s, got %s", t.Name(), in, want, d)
+		}
+	}
+
+	for in, want := range map[string]string{
+		`1.3.6.1.4.1.56521`:         `myOID`,
+		`1.3.6.1.4.1.56521.101.2`:   `myAttrs`,
+		`1.3.6.1.4.1.56521.101.2.5`: `myAttrs:5`,
+		`1.3.6.1.4.1.56521.102`:     `myOID:102`,
+		`2.5.4.3`:                   `2.5.4.3`,
+	} {
+		d, _ := NewDotNotation(in)
+		if got, ok := macros.Shortest(*d); got != want {
+			t.Errorf("%s failed: %s: want %s, got %s", t.Name(), in, want, got)
+		} else if ok != (want != in) {
+			t.Errorf("%s failed: %s: unexpected Boolean result", t.Name(), in)
+		}
+	}
+
+	for _, bogus := range []string{`undefined:1`, `myOID:`, `myOID:1..2`, `myOID:x`} {
+		if _, err := macros.Expand(bogus); err == nil {
+			t.Errorf("%s failed: expected error for '%s'", t.Name(), bogus)
+		}
+	}
+
+	// malformed numeric OIDs are not mistaken for undefined macros
+	if _, err := macros.Expand(`1.3.x`); !errors.Is(err, ErrInvalidNumber) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidNumber, err)
+	}
+
+	for _, bogus := range [][2]string{{`myOID`, `1.2.3`}, {`1bad`, `1.2.3`}, {`fine`, `nope:1`}} {
+		if err := macros.Set(bogus[0], bogus[1]); err == nil {
+			t.Errorf("%s failed: expected error for %v", t.Name(), bogus)
+		}
+	}
+
+	err := NewMacroTable().Load(strings.NewReader("\n\nobjectIdentifier orphan missing:1\n"))
+	if err == nil || !hasPrefix(err.Error(), `Line 3:`) {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+	}
+}
+
+func TestMacroTable_LoadLDIF(t *testing.T) {
+	// slapcat output, bearing X-ORDERED prefixes
+	// and RFC 2849 folding (note the trailing space
+	// preceding the second fold).
+	const ldif = "dn: cn={0}example,cn=schema,cn=config\n" +
+		"objectClass: olcSchemaConfig\n" +
+		"cn: {0}example\n" +
+		"olcObjectIdentifier: {0}myOID 1.3.6.1.4.1.56\n" +
+		" 521\n" +
+		"olcObjectIdentifier: {1}myLDAP \n" +
+		" myOID:101\n" +
+		"olcObjectIdentifier:{2}myAttrs myLDAP:2\n" +
+		"olcAttributeTypes: {0}( myAttrs:1 NAME 'exampleAttr' SYNTAX 1.3.6.1.4.1.1466.1\n" +
+		" 15.121.1.15 )\n"
+
+	macros := NewMacroTable()
+	if err := macros.Load(strings.NewReader(ldif)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	for in, want := range map[string]string{
+		`myOID`:     `1.3.6.1.4.1.56521`,
+		`myLDAP`:    `1.3.6.1.4.1.56521.101`,
+		`myAttrs:1`: `1.3.6.1.4.1.56521.101.2.1`,
+	} {
+		if d, err := macros.Expand(in); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if d.String() != want {
+			t.Errorf("%s failed: %s: want %s, got %s", t.Name(), in, want, d)
+		}
+	}
+
+	// slapd.conf continuations remain joined by a space.
+	macros = NewMacroTable()
+	if err := macros.Load(strings.NewReader("objectIdentifier myOID\n 1.3.6.1.4.1.56521\n")); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if d, _ := macros.Lookup(`myOID`); d.String() != `1.3.6.1.4.1.56521` {
+		t.Errorf("%s failed: unexpected slapd.conf continuation result %s", t.Name(), d)
+	}
+
+	for in, want := range map[string]string{
+		`{0}myOID`:  `myOID`,
+		`{12}myOID`: `myOID`,
+		`{x}myOID`:  `{x}myOID`,
+		`{}myOID`:   `{}myOID`,
+		`myOID`:     `myOID`,
+	} {
+		if got := trimOrdered(in); got != want {
+			t.Errorf("%s failed: %s: want %s, got %s", t.Name(), in, want, got)
+		}
+	}
+}
@@ -0,0 +1,432 @@
+package objectid
+
+/*
+marshal.go implements the encoding.TextMarshaler, encoding.TextUnmarshaler,
+encoding.BinaryMarshaler and encoding.BinaryUnmarshaler interfaces for the
+types within this package.
+
+Throughout, zero instances marshal to zero length text, and zero length
+text unmarshals into a zero instance without error.
+
+Additionally, the encoding/json.Marshaler and encoding/json.Unmarshaler
+interfaces are implemented for the OID type, allowing a richer, structured
+representation.
+*/
+
+import "encoding/json"
+
+/*
+MarshalText returns the base-10 text representation of the receiver
+instance alongside an error, thereby implementing the [encoding.TextMarshaler]
+interface.
+*/
+func (r NumberForm) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the base-10
+text into the receiver instance, thereby implementing the
+[encoding.TextUnmarshaler] interface.
+*/
+func (r *NumberForm) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = nilNF
+		return
+	}
+
+	var nf NumberForm
+	if nf, err = NewNumberForm(string(text)); err == nil {
+		*r = nf
+	}
+
+	return
+}
+
+/*
+MarshalText returns the dot notation text representation of the receiver
+instance (e.g.: "1.3.6.1") alongside an error, thereby implementing the
+[encoding.TextMarshaler] interface.
+*/
+func (r DotNotation) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the dot
+notation text into the receiver instance, thereby implementing the
+[encoding.TextUnmarshaler] interface.
+*/
+func (r *DotNotation) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = make(DotNotation, 0)
+		return
+	}
+
+	var d *DotNotation
+	if d, err = NewDotNotation(string(text)); err == nil {
+		*r = *d
+	}
+
+	return
+}
+
+/*
+MarshalBinary returns the ASN.1 DER encoding of the receiver instance
+alongside an error, thereby implementing the [encoding.BinaryMarshaler]
+interface. See [DotNotation.Encode] for details.
+*/
+func (r DotNotation) MarshalBinary() (b []byte, err error) {
+	if !r.IsZero() {
+		b, err = r.Encode()
+	}
+
+	return
+}
+
+/*
+UnmarshalBinary returns an error following an attempt to decode the ASN.1
+DER encoding into the receiver instance, thereby implementing the
+[encoding.BinaryUnmarshaler] interface. Decoding is conducted using the
+[Strict] [DecodeOption].
+*/
+func (r *DotNotation) UnmarshalBinary(b []byte) (err error) {
+	if len(b) == 0 {
+		*r = make(DotNotation, 0)
+		return
+	}
+
+	return r.Decode(b, Strict)
+}
+
+/*
+MarshalText returns the dot notation text representation of the receiver
+instance (e.g.: "4.1.56521") alongside an error, thereby implementing the
+[encoding.TextMarshaler] interface.
+*/
+func (r RelativeOID) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the dot
+notation text into the receiver instance, thereby implementing the
+[encoding.TextUnmarshaler] interface.
+*/
+func (r *RelativeOID) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = make(RelativeOID, 0)
+		return
+	}
+
+	var rel *RelativeOID
+	if rel, err = NewRelativeOID(string(text)); err == nil {
+		*r = *rel
+	}
+
+	return
+}
+
+/*
+MarshalText returns the OID-IRI text representation of the receiver
+instance (e.g.: "/ISO/Identified-Organization/6") alongside an error,
+thereby implementing the [encoding.TextMarshaler] interface.
+*/
+func (r IRINotation) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the OID-IRI
+text into the receiver instance, thereby implementing the
+[encoding.TextUnmarshaler] interface.
+*/
+func (r *IRINotation) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = make(IRINotation, 0)
+		return
+	}
+
+	var iri *IRINotation
+	if iri, err = NewIRINotation(string(text)); err == nil {
+		*r = *iri
+	}
+
+	return
+}
+
+/*
+MarshalText returns the text representation of the receiver instance
+(e.g.: "enterprise(1)") alongside an error, thereby implementing the
+[encoding.TextMarshaler] interface.
+*/
+func (r NameAndNumberForm) MarshalText() (text []byte, err error) {
+	if !r.IsZero() {
+		text = []byte(r.String())
+	}
+
+	return
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the text into
+the receiver instance, thereby implementing the [encoding.TextUnmarshaler]
+interface.
+*/
+func (r *NameAndNumberForm) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = NameAndNumberForm{}
+		return
+	}
+
+	var nanf *NameAndNumberForm
+	if nanf, err = NewNameAndNumberForm(string(text)); err == nil {
+		*r = *nanf
+	}
+
+	return
+}
+
+/*
+MarshalText returns the ASN.1 text representation of the receiver instance
+(e.g.: "{iso(1) identified-organization(3)}") alongside an error, thereby
+implementing the [encoding.TextMarshaler] interface.
+*/
+func (r ASN1Notation) MarshalText() (text []byte, err error) {
+	if !r.IsZero() {
+		text = []byte(r.String())
+	}
+
+	return
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the ASN.1 text
+into the receiver instance, thereby implementing the [encoding.TextUnmarshaler]
+interface.
+*/
+func (r *ASN1Notation) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = make(ASN1Notation, 0)
+		return
+	}
+
+	var asn *ASN1Notation
+	if asn, err = NewASN1Notation(string(text)); err == nil {
+		*r = *asn
+	}
+
+	return
+}
+
+/*
+MarshalText returns the ASN.1 text representation of the receiver instance
+(e.g.: "{iso(1) identified-organization(3)}") alongside an error, thereby
+implementing the [encoding.TextMarshaler] interface.
+*/
+func (r OID) MarshalText() (text []byte, err error) {
+	if !r.IsZero() {
+		text = []byte(r.String())
+	}
+
+	return
+}
+
+/*
+UnmarshalText returns an error following an attempt to read the ASN.1 text
+into the receiver instance, thereby implementing the [encoding.TextUnmarshaler]
+interface.
+*/
+func (r *OID) UnmarshalText(text []byte) (err error) {
+	if len(text) == 0 {
+		*r = OID{}
+		return
+	}
+
+	var id *OID
+	if id, err = NewOID(string(text)); err == nil {
+		*r = *id
+	}
+
+	return
+}
+
+/*
+oidJSON is the structured JSON representation of an [OID] instance.
+*/
+type oidJSON struct {
+	Dot  string    `json:"dot,omitempty"`
+	ASN  string    `json:"asn,omitempty"`
+	Arcs []arcJSON `json:"arcs,omitempty"`
+}
+
+/*
+arcJSON is the structured JSON representation of a [NameAndNumberForm]
+instance. The number form is represented as a string, as it is unbounded.
+*/
+type arcJSON struct {
+	Identifier           string   `json:"identifier,omitempty"`
+	Number               string   `json:"number"`
+	SecondaryIdentifiers []string `json:"secondaryIdentifiers,omitempty"`
+	UnicodeLabels        []string `json:"unicodeLabels,omitempty"`
+	LongArc              bool     `json:"longArc,omitempty"`
+}
+
+/*
+MarshalJSON returns the structured JSON representation of the receiver
+instance alongside an error, thereby implementing the [encoding/json.Marshaler]
+interface. Both the dot and ASN.1 notations are preserved, as are the
+identifiers and number forms of each arc. For example:
+
+	{
+	  "dot": "1.3.6",
+	  "asn": "{iso(1) identified-organization(3) dod(6)}",
+	  "arcs": [
+	    {"identifier": "iso", "number": "1"},
+	    {"identifier": "identified-organization", "number": "3"},
+	    {"identifier": "dod", "number": "6"}
+	  ]
+	}
+
+A zero receiver instance is represented as JSON null.
+*/
+func (r OID) MarshalJSON() ([]byte, error) {
+	if r.IsZero() {
+		return []byte(`null`), nil
+	}
+
+	j := oidJSON{
+		Dot:  r.Dot().String(),
+		ASN:  r.String(),
+		Arcs: make([]arcJSON, r.Len()),
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		j.Arcs[i] = arcJSON{
+			Identifier:           r.nanf[i].Identifier(),
+			Number:               r.nanf[i].NumberForm().String(),
+			SecondaryIdentifiers: r.nanf[i].SecondaryIdentifiers(),
+			UnicodeLabels:        r.nanf[i].UnicodeLabels(),
+			LongArc:              r.nanf[i].LongArc(),
+		}
+	}
+
+	return json.Marshal(j)
+}
+
+/*
+UnmarshalJSON returns an error following an attempt to read the JSON input
+into the receiver instance, thereby implementing the [encoding/json.Unmarshaler]
+interface. Valid input forms are:
+
+  - a dot notation string (e.g.: "1.3.6.1")
+  - an ASN.1 notation string (e.g.: "{iso(1) identified-organization(3)}")
+  - the structured object produced by [OID.MarshalJSON]
+
+When the structured object is used, "arcs" takes precedence over "asn",
+which takes precedence over "dot". Any notations present must agree with
+one another, else an error is returned.
+*/
+func (r *OID) UnmarshalJSON(b []byte) (err error) {
+	var str string
+	if err = json.Unmarshal(b, &str); err == nil {
+		if b[0] == 'n' { // null
+			*r = OID{}
+			return
+		}
+		return r.unmarshalString(str)
+	}
+
+	var j oidJSON
+	if err = json.Unmarshal(b, &j); err != nil {
+		return
+	}
+
+	var id OID
+	switch {
+	case len(j.Arcs) > 0:
+		err = id.unmarshalArcs(j.Arcs)
+	case len(j.ASN) > 0:
+		err = id.unmarshalString(j.ASN)
+	case len(j.Dot) > 0:
+		err = id.unmarshalString(j.Dot)
+	default:
+		err = errorf("No %T content found within JSON input", id)
+	}
+
+	if err == nil {
+		if len(j.Dot) > 0 && id.Dot().String() != j.Dot {
+			err = errorf("JSON dot notation '%s' does not agree with '%s'", j.Dot, id.Dot())
+		} else if len(j.ASN) > 0 && len(j.Arcs) > 0 && !id.matchASN(j.ASN) {
+			err = errorf("JSON ASN.1 notation '%s' does not agree with '%s'", j.ASN, id)
+		} else {
+			*r = id
+		}
+	}
+
+	return
+}
+
+func (r *OID) unmarshalString(str string) (err error) {
+	str = trimS(str)
+	if len(str) == 0 {
+		*r = OID{}
+		return
+	} else if str[0] == '{' {
+		return r.UnmarshalText([]byte(str))
+	}
+
+	var dot *DotNotation
+	if dot, err = NewDotNotation(str); err == nil {
+		nanfs := make([]NameAndNumberForm, dot.Len())
+		for i := 0; i < dot.Len(); i++ {
+			nanfs[i] = NameAndNumberForm{primaryIdentifier: (*dot)[i], parsed: true}
+		}
+
+		var id *OID
+		if id, err = NewOID(nanfs); err == nil {
+			*r = *id
+		}
+	}
+
+	return
+}
+
+func (r *OID) unmarshalArcs(arcs []arcJSON) (err error) {
+	nanfs := make([]NameAndNumberForm, len(arcs))
+	for i := 0; i < len(arcs) && err == nil; i++ {
+		raw := arcs[i].Number
+		if len(arcs[i].Identifier) > 0 {
+			raw = sprintf("%s(%s)", arcs[i].Identifier, arcs[i].Number)
+		}
+
+		var nanf *NameAndNumberForm
+		if nanf, err = NewNameAndNumberForm(raw); err != nil {
+			break
+		} else if err = nanf.SetSecondaryIdentifiers(arcs[i].SecondaryIdentifiers...); err != nil {
+			break
+		} else if err = nanf.SetUnicodeLabels(arcs[i].UnicodeLabels...); err != nil {
+			break
+		} else if err = nanf.SetLongArc(arcs[i].LongArc); err == nil {
+			nanfs[i] = *nanf
+		}
+	}
+
+	if err == nil {
+		var id *OID
+		if id, err = NewOID(nanfs); err == nil {
+			*r = *id
+		}
+	}
+
+	return
+}
+
+/*
+matchASN returns a Boolean value indicative of whether the input ASN.1
+notation string matches the receiver instance.
+*/
+func (r OID) matchASN(asn string) bool {
+	A, err := NewASN1Notation(asn)
+	return err == nil && A.String() == r.String()
+}
@@ -0,0 +1,204 @@
+package objectid
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"testing"
+)
+
+func ExampleDotNotation_UnmarshalText() {
+	var config struct {
+		Base DotNotation `json:"base"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"base":"1.3.6.1.4.1.56521"}`), &config); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", config.Base)
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func ExampleDotNotation_MarshalBinary() {
+	dot, _ := NewDotNotation(`1.3.6.1.4.1.56521.999.5`)
+	b, err := dot.MarshalBinary()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%v", b)
+	// Output: [6 11 43 6 1 4 1 131 185 73 135 103 5]
+}
+
+func TestMarshalText_roundTrip(t *testing.T) {
+	for _, pair := range []struct {
+		text string
+		typ  interface {
+			encoding.TextMarshaler
+			encoding.TextUnmarshaler
+		}
+	}{
+		{`987895962269883002155146617097157934`, new(NumberForm)},
+		{`2.25.987895962269883002155146617097157934`, new(DotNotation)},
+		{`4.1.56521`, new(RelativeOID)},
+		{`/Joint-ISO-ITU-T/25/1`, new(IRINotation)},
+		{`enterprise(1)`, new(NameAndNumberForm)},
+		{`{iso(1) identified-organization(3) dod(6)}`, new(ASN1Notation)},
+		{`{iso(1) identified-organization(3) dod(6)}`, new(OID)},
+	} {
+		if err := pair.typ.UnmarshalText([]byte(pair.text)); err != nil {
+			t.Errorf("%s failed [%T]: %v", t.Name(), pair.typ, err)
+			continue
+		}
+
+		if got, err := pair.typ.MarshalText(); err != nil {
+			t.Errorf("%s failed [%T]: %v", t.Name(), pair.typ, err)
+		} else if string(got) != pair.text {
+			t.Errorf("%s failed [%T]: want '%s', got '%s'", t.Name(), pair.typ, pair.text, got)
+		}
+
+		if err := pair.typ.UnmarshalText([]byte(`{bogus`)); err == nil {
+			t.Errorf("%s failed [%T]: bogus text unmarshaled without error", t.Name(), pair.typ)
+		}
+
+		if err := pair.typ.UnmarshalText(nil); err != nil {
+			t.Errorf("%s failed [%T]: %v", t.Name(), pair.typ, err)
+		} else if got, _ := pair.typ.MarshalText(); len(got) != 0 && string(got) != `0` {
+			t.Errorf("%s failed [%T]: expected zero text, got '%s'", t.Name(), pair.typ, got)
+		}
+	}
+}
+
+func TestMarshal_stdlib(t *testing.T) {
+	type doc struct {
+		XMLName xml.Name    `xml:"doc"`
+		Dot     DotNotation `xml:"dot,attr" json:"dot"`
+		ID      OID         `xml:"id" json:"id"`
+	}
+
+	var in doc
+	_ = in.Dot.UnmarshalText([]byte(`1.3.6.1`))
+	_ = in.ID.UnmarshalText([]byte(`{iso(1) identified-organization(3)}`))
+
+	b, err := xml.Marshal(in)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var out doc
+	if err = xml.Unmarshal(b, &out); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if out.Dot.String() != in.Dot.String() || out.ID.String() != in.ID.String() {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), in, out)
+	}
+
+	var dot DotNotation
+	fs := flag.NewFlagSet(t.Name(), flag.ContinueOnError)
+	fs.TextVar(&dot, `oid`, DotNotation{}, `base OID`)
+	if err = fs.Parse([]string{`-oid`, `1.3.6.1.4.1.56521`}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.String() != `1.3.6.1.4.1.56521` {
+		t.Errorf("%s failed: unexpected flag value '%s'", t.Name(), dot)
+	}
+}
+
+func TestDotNotation_binary(t *testing.T) {
+	var dot DotNotation
+	if b, err := dot.MarshalBinary(); err != nil || len(b) != 0 {
+		t.Errorf("%s failed: zero %T marshaled to %v (%v)", t.Name(), dot, b, err)
+	}
+
+	if err := dot.UnmarshalBinary([]byte{0x06, 0x03, 0x2b, 0x80, 0x06}); err == nil {
+		t.Errorf("%s failed: non-DER encoding unmarshaled without error", t.Name())
+	}
+
+	if err := dot.UnmarshalBinary([]byte{0x06, 0x02, 0x2b, 0x06}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if err = dot.UnmarshalBinary(nil); err != nil || !dot.IsZero() {
+		t.Errorf("%s failed: expected zero %T, got %s (%v)", t.Name(), dot, dot, err)
+	}
+}
+
+func ExampleOID_MarshalJSON() {
+	id, _ := NewOID(`{iso(1) identified-organization(3) dod(6)}`)
+	b, err := json.Marshal(id)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", b)
+	// Output: {"dot":"1.3.6","asn":"{iso(1) identified-organization(3) dod(6)}","arcs":[{"identifier":"iso","number":"1"},{"identifier":"identified-organization","number":"3"},{"identifier":"dod","number":"6"}]}
+}
+
+func TestOID_JSON(t *testing.T) {
+	want := `{iso(1) identified-organization(3) dod(6) 1}`
+	for _, raw := range []string{
+		`"{iso(1) identified-organization(3) dod(6) 1}"`,
+		`{"asn":"{iso(1) identified-organization(3) dod(6) 1}"}`,
+		`{"dot":"1.3.6.1","asn":"{iso(1) identified-organization(3) dod(6) 1}"}`,
+		`{"dot":"1.3.6.1","arcs":[{"identifier":"iso","number":"1"},` +
+			`{"identifier":"identified-organization","number":"3"},` +
+			`{"identifier":"dod","number":"6"},{"number":"1"}]}`,
+	} {
+		var id OID
+		if err := json.Unmarshal([]byte(raw), &id); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if id.String() != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, id)
+		}
+	}
+
+	var id OID
+	if err := json.Unmarshal([]byte(`"1.3.6.1"`), &id); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if id.String() != `{1 3 6 1}` {
+		t.Errorf("%s failed: unexpected %T '%s'", t.Name(), id, id)
+	}
+
+	// X.660 details must survive a round trip
+	nanf, _ := NewNameAndNumberForm(`example(999)`)
+	_ = nanf.SetSecondaryIdentifiers(`sample`)
+	_ = nanf.SetUnicodeLabels(`Example`)
+	_ = nanf.SetLongArc(true)
+	root, _ := NewNameAndNumberForm(`joint-iso-itu-t(2)`)
+	in, _ := NewOID([]NameAndNumberForm{*root, *nanf})
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = json.Unmarshal(b, &id); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if leaf := id.Leaf(); !leaf.LongArc() || len(leaf.UnicodeLabels()) != 1 ||
+		len(leaf.SecondaryIdentifiers()) != 1 {
+		t.Errorf("%s failed: X.660 details lost: %s", t.Name(), b)
+	}
+
+	for _, bogus := range []string{
+		`{}`,
+		`[]`,
+		`"1.3.6..1"`,
+		`{"dot":"1.3.6.2","asn":"{iso(1) identified-organization(3) dod(6) 1}"}`,
+		`{"asn":"{iso(1) 3}","arcs":[{"number":"1"},{"number":"4"}]}`,
+		`{"arcs":[{"identifier":"Bogus","number":"1"}]}`,
+	} {
+		if err = json.Unmarshal([]byte(bogus), &id); err == nil {
+			t.Errorf("%s failed: bogus JSON %s unmarshaled without error", t.Name(), bogus)
+		}
+	}
+
+	if err = json.Unmarshal([]byte(`null`), &id); err != nil || !id.IsZero() {
+		t.Errorf("%s failed: expected zero %T (%v)", t.Name(), id, err)
+	} else if b, _ = json.Marshal(id); string(b) != `null` {
+		t.Errorf("%s failed: want null, got %s", t.Name(), b)
+	}
+}
@@ -9,27 +9,31 @@ import (
 )
 
 var (
-	printf     func(string, ...any) (int, error)      = fmt.Printf
-	sprintf    func(string, ...any) string            = fmt.Sprintf
-	atoi       func(string) (int, error)              = strconv.Atoi
-	puint64    func(string, int, int) (uint64, error) = strconv.ParseUint
-	contains   func(string, string) bool              = strings.Contains
-	eq         func(string, string) bool              = strings.EqualFold
-	fields     func(string) []string                  = strings.Fields
-	hasPrefix  func(string, string) bool              = strings.HasPrefix
-	hasSuffix  func(string, string) bool              = strings.HasSuffix
-	indexRune  func(string, rune) int                 = strings.IndexRune
-	join       func([]string, string) string          = strings.Join
-	split      func(string, string) []string          = strings.Split
-	splitAfter func(string, string) []string          = strings.SplitAfter
-	splitN     func(string, string, int) []string     = strings.SplitN
-	trimS      func(string) string                    = strings.TrimSpace
-	trimL      func(string, string) string            = strings.TrimLeft
-	trimR      func(string, string) string            = strings.TrimRight
-	isDigit    func(rune) bool                        = unicode.IsDigit
-	isLetter   func(rune) bool                        = unicode.IsLetter
-	isLower    func(rune) bool                        = unicode.IsLower
-	isUpper    func(rune) bool                        = unicode.IsUpper
+	printf     func(string, ...any) (int, error)           = fmt.Printf
+	sprintf    func(string, ...any) string                 = fmt.Sprintf
+	atoi       func(string) (int, error)                   = strconv.Atoi
+	puint64    func(string, int, int) (uint64, error)      = strconv.ParseUint
+	contains   func(string, string) bool                   = strings.Contains
+	cut        func(string, string) (string, string, bool) = strings.Cut
+	eq         func(string, string) bool                   = strings.EqualFold
+	fields     func(string) []string                       = strings.Fields
+	hasPrefix  func(string, string) bool                   = strings.HasPrefix
+	hasSuffix  func(string, string) bool                   = strings.HasSuffix
+	index      func(string, string) int                    = strings.Index
+	indexByte  func(string, byte) int                      = strings.IndexByte
+	indexRune  func(string, rune) int                      = strings.IndexRune
+	join       func([]string, string) string               = strings.Join
+	lc         func(string) string                         = strings.ToLower
+	split      func(string, string) []string               = strings.Split
+	splitAfter func(string, string) []string               = strings.SplitAfter
+	splitN     func(string, string, int) []string          = strings.SplitN
+	trimS      func(string) string                         = strings.TrimSpace
+	trimL      func(string, string) string                 = strings.TrimLeft
+	trimR      func(string, string) string                 = strings.TrimRight
+	isDigit    func(rune) bool                             = unicode.IsDigit
+	isLetter   func(rune) bool                             = unicode.IsLetter
+	isLower    func(rune) bool                             = unicode.IsLower
+	isUpper    func(rune) bool                             = unicode.IsUpper
 )
 
 func errorf(msg any, x ...any) (err error) {
@@ -49,12 +53,21 @@ specified string (str) is present within slice. Please note
 that case is a significant element in the matching process.
 */
 func strInSlice(str string, slice []string) bool {
+	return strIndex(str, slice) != -1
+}
+
+/*
+strIndex returns the index of the specified string (str) within
+slice, or -1 if not present. Case is significant, as with
+strInSlice.
+*/
+func strIndex(str string, slice []string) int {
 	for i := 0; i < len(slice); i++ {
 		if str == slice[i] {
-			return true
+			return i
 		}
 	}
-	return false
+	return -1
 }
 
 /*
@@ -159,6 +172,32 @@ func isIdentifier(val string) bool {
 	return true
 }
 
+/*
+isKeystring returns a Boolean value indicative of whether val qualifies
+as an RFC 4512 keystring, in that:
+
+  - It is non-zero in length
+  - It begins with an ASCII alpha
+  - It contains only ASCII alphanumeric characters or hyphens
+*/
+func isKeystring(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		ch := val[i]
+		switch {
+		case 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z':
+		case i > 0 && ('0' <= ch && ch <= '9' || ch == '-'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 /*
 compare slice members of two (2) []int instances.
 */
@@ -0,0 +1,736 @@
+package objectid
+
+/*
+module.go implements a parser for OBJECT IDENTIFIER value assignments
+found within ASN.1 modules, per ITU-T Rec. X.680, as well as the OID
+bearing macro assignments found within SNMP SMIv1 and SMIv2 MIB modules.
+*/
+
+import (
+	"bufio"
+	"io"
+)
+
+/*
+Assignment is a single resolved OBJECT IDENTIFIER value assignment, such
+as "id-pkix OBJECT IDENTIFIER ::= { ... }", found within an ASN.1 module.
+*/
+type Assignment struct {
+	name   string
+	kind   string
+	module string
+	line   int
+	oid    OID
+}
+
+/*
+Name returns the value reference (e.g.: "id-pkix") of the receiver instance.
+*/
+func (r Assignment) Name() string {
+	return r.name
+}
+
+/*
+Kind returns the type or macro of the receiver instance, such as "OBJECT
+IDENTIFIER" or "OBJECT-TYPE".
+*/
+func (r Assignment) Kind() string {
+	return r.kind
+}
+
+/*
+Module returns the name of the ASN.1 module in which the receiver instance
+was defined.
+*/
+func (r Assignment) Module() string {
+	return r.module
+}
+
+/*
+Line returns the line number at which the receiver instance was defined.
+*/
+func (r Assignment) Line() int {
+	return r.line
+}
+
+/*
+OID returns the resolved [OID] value of the receiver instance.
+*/
+func (r Assignment) OID() OID {
+	return r.oid
+}
+
+/*
+ASN returns the resolved [ASN1Notation] value of the receiver instance.
+*/
+func (r Assignment) ASN() ASN1Notation {
+	return r.oid.ASN()
+}
+
+/*
+String is a stringer method that returns the receiver instance in the
+abbreviated form of an ASN.1 value assignment, less any macro clauses.
+*/
+func (r Assignment) String() string {
+	return sprintf("%s %s ::= %s", r.name, r.kind, r.oid)
+}
+
+/*
+ModuleSet is a collection of parsed ASN.1 modules, whose OBJECT IDENTIFIER
+value assignments may reference one another by way of IMPORTS. Instances of
+this type should only be initialized using the [NewModuleSet] function.
+*/
+type ModuleSet struct {
+	resolver Resolver
+	modules  map[string]*asn1Module
+	order    []string
+	resolved map[*rawAssignment]*Assignment
+}
+
+/*
+asn1Module is a single parsed ASN.1 module.
+*/
+type asn1Module struct {
+	name        string
+	source      string
+	imports     map[string]string // symbol -> module name
+	assignments map[string]*rawAssignment
+	order       []string
+}
+
+/*
+rawAssignment is an unresolved OBJECT IDENTIFIER value assignment.
+*/
+type rawAssignment struct {
+	name       string
+	kind       string
+	module     *asn1Module
+	line       int
+	components []asn1Token
+}
+
+/*
+NewModuleSet returns a freshly initialized instance of *[ModuleSet]. An
+optional [Resolver], such as a *[Registry], may be supplied; it shall be
+consulted for name-only arcs which are neither root arcs nor references
+to other value assignments.
+*/
+func NewModuleSet(resolver ...Resolver) (r *ModuleSet) {
+	r = &ModuleSet{modules: make(map[string]*asn1Module)}
+	for i := 0; i < len(resolver) && r.resolver == nil; i++ {
+		r.resolver = resolver[i]
+	}
+
+	return
+}
+
+/*
+Parse returns an error following an attempt to read one (1) or more ASN.1
+modules from src. The source value names the input (e.g.: a file name) for
+the purpose of error reporting.
+
+Only module headers, IMPORTS, OBJECT IDENTIFIER value assignments and the
+values of the SMI macros OBJECT-TYPE, MODULE-IDENTITY, OBJECT-IDENTITY,
+NOTIFICATION-TYPE, OBJECT-GROUP, NOTIFICATION-GROUP, MODULE-COMPLIANCE and
+AGENT-CAPABILITIES are interpreted; all other content, including MACRO
+definitions, is skipped. Where the leaf arc of an SMI macro value bears no
+name, the value reference is used (e.g.: "ifIndex OBJECT-TYPE ... ::=
+{ ifEntry 1 }" yields "ifIndex(1)"); the leaf arcs of plain OBJECT IDENTIFIER
+assignments are left as written. References are not resolved until
+[ModuleSet.Resolve] is called, thus modules may be parsed in any order.
+*/
+func (r *ModuleSet) Parse(source string, src io.Reader) (err error) {
+	var toks []asn1Token
+	if toks, err = lexASN1(src); err != nil {
+		err = errorf("%s:%v", source, err)
+		return
+	}
+
+	p := &asn1Parser{toks: toks, source: source}
+	var mods []*asn1Module
+	if mods, err = p.modules(); err != nil {
+		return
+	}
+
+	for _, mod := range mods {
+		if _, exists := r.modules[mod.name]; exists {
+			err = errorf("%s: Duplicate module '%s'", source, mod.name)
+			return
+		}
+	}
+
+	for _, mod := range mods {
+		r.modules[mod.name] = mod
+		r.order = append(r.order, mod.name)
+	}
+	r.resolved = nil
+
+	return
+}
+
+/*
+Resolve returns slices of [Assignment] instances alongside an error following
+an attempt to resolve every OBJECT IDENTIFIER value assignment found within
+the receiver. Assignments are returned in module order, followed by order of
+appearance.
+
+An error is returned, bearing the source name and line number, upon the first
+undefined reference, unresolvable name-only arc, or cyclic reference found.
+*/
+func (r *ModuleSet) Resolve() (assignments []Assignment, err error) {
+	r.resolved = make(map[*rawAssignment]*Assignment)
+
+	for _, name := range r.order {
+		mod := r.modules[name]
+		for _, aname := range mod.order {
+			var a *Assignment
+			if a, err = r.resolve(mod.assignments[aname], nil); err != nil {
+				r.resolved = nil
+				return
+			}
+			assignments = append(assignments, *a)
+		}
+	}
+
+	return
+}
+
+/*
+Lookup returns the [Assignment] bearing the input value reference within the
+named module, alongside a Boolean value indicative of success. [ModuleSet.Resolve]
+must have been called successfully beforehand.
+*/
+func (r *ModuleSet) Lookup(module, name string) (a Assignment, ok bool) {
+	if mod, found := r.modules[module]; found && r.resolved != nil {
+		if raw, exists := mod.assignments[name]; exists {
+			var res *Assignment
+			if res, ok = r.resolved[raw]; ok {
+				a = *res
+			}
+		}
+	}
+
+	return
+}
+
+/*
+ParseModule is a convenience function which parses and resolves the ASN.1
+module(s) found within src, returning slices of [Assignment] alongside an
+error. See [ModuleSet] for details.
+*/
+func ParseModule(src io.Reader, resolver ...Resolver) (assignments []Assignment, err error) {
+	set := NewModuleSet(resolver...)
+	if err = set.Parse(`input`, src); err == nil {
+		assignments, err = set.Resolve()
+	}
+
+	return
+}
+
+/*
+resolve resolves the input raw assignment, recursively resolving any
+referenced assignments. The chain slice tracks assignments currently
+being resolved, allowing detection of cycles.
+*/
+func (r *ModuleSet) resolve(raw *rawAssignment, chain []*rawAssignment) (a *Assignment, err error) {
+	if a = r.resolved[raw]; a != nil {
+		return
+	}
+
+	for i, c := range chain {
+		if c == raw {
+			var names []string
+			for _, x := range append(chain[i:], raw) {
+				names = append(names, x.name)
+			}
+			err = errorf("%s:%d: Cyclic reference: %s",
+				raw.module.source, raw.line, join(names, ` -> `))
+			return
+		}
+	}
+	chain = append(chain, raw)
+
+	var asn ASN1Notation
+	for i, tok := range raw.components {
+		var nanf NameAndNumberForm
+		switch {
+		case tok.number != ``:
+			var nf NumberForm
+			if nf, err = NewNumberForm(tok.number); err != nil {
+				err = errorf("%s:%d: %v", raw.module.source, tok.line, err)
+				return
+			}
+			nanf = NameAndNumberForm{identifier: tok.text, primaryIdentifier: nf, parsed: true}
+		case i == 0:
+			var ref *rawAssignment
+			if ref, err = r.reference(raw.module, tok); err == nil {
+				var res *Assignment
+				if res, err = r.resolve(ref, chain); err != nil {
+					return
+				}
+				asn = append(asn, res.oid.nanf...)
+				continue
+			} else if root, rerr := parseRootNameOnly(tok.text); rerr == nil {
+				err = nil
+				nanf = *root
+			} else {
+				return
+			}
+		default:
+			var nf NumberForm
+			var ok bool
+			if r.resolver != nil {
+				nf, ok = r.resolver.ResolveName(asn.numberForms(), tok.text)
+			}
+			if !ok {
+				err = errorf("%s:%d: Unable to resolve name-only arc '%s' within '%s'",
+					raw.module.source, tok.line, tok.text, raw.name)
+				return
+			}
+			nanf = NameAndNumberForm{identifier: tok.text, primaryIdentifier: nf, parsed: true}
+		}
+
+		asn = append(asn, nanf)
+	}
+
+	if !asn.Valid() {
+		err = errorf("%s:%d: Invalid OBJECT IDENTIFIER value '%s' for '%s'",
+			raw.module.source, raw.line, asn, raw.name)
+		return
+	}
+
+	// an unnamed leaf arc of an SMI macro value assumes
+	// the name of the value reference, as is customary
+	// for MIBs.
+	if leaf := &asn[len(asn)-1]; leaf.identifier == `` && strInSlice(raw.kind, smiMacros) {
+		leaf.identifier = raw.name
+	}
+
+	a = &Assignment{
+		name:   raw.name,
+		kind:   raw.kind,
+		module: raw.module.name,
+		line:   raw.line,
+		oid:    OID{nanf: asn, parsed: true},
+	}
+	r.resolved[raw] = a
+
+	return
+}
+
+/*
+reference returns the raw assignment referenced by tok within mod, whether
+defined locally or imported, alongside an error.
+*/
+func (r *ModuleSet) reference(mod *asn1Module, tok asn1Token) (ref *rawAssignment, err error) {
+	if ref = mod.assignments[tok.text]; ref != nil {
+		return
+	}
+
+	if from, imported := mod.imports[tok.text]; imported {
+		if other, loaded := r.modules[from]; !loaded {
+			err = errorf("%s:%d: '%s' imported from module '%s', which has not been parsed",
+				mod.source, tok.line, tok.text, from)
+		} else if ref = other.assignments[tok.text]; ref == nil {
+			err = errorf("%s:%d: '%s' is not defined within module '%s'",
+				mod.source, tok.line, tok.text, from)
+		}
+		return
+	}
+
+	err = errorf("%s:%d: Undefined reference '%s'", mod.source, tok.line, tok.text)
+
+	return
+}
+
+/*
+asn1Token is a single lexical item read from an ASN.1 module. Within the
+components of a value assignment, number is set for number forms, while
+text bears the identifier, if any.
+*/
+type asn1Token struct {
+	text   string
+	number string
+	line   int
+}
+
+/*
+lexASN1 reads src and returns its lexical items, less comments.
+*/
+func lexASN1(src io.Reader) (toks []asn1Token, err error) {
+	var data []byte
+	if data, err = io.ReadAll(bufio.NewReader(src)); err != nil {
+		return
+	}
+
+	line := 1
+	for i := 0; i < len(data); {
+		c := data[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case c == '-' && i+1 < len(data) && data[i+1] == '-':
+			// comment ends at next "--" or end of line
+			for i += 2; i < len(data) && data[i] != '\n'; i++ {
+				if data[i] == '-' && i+1 < len(data) && data[i+1] == '-' {
+					i += 2
+					break
+				}
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			// block comments may be nested
+			depth, start := 1, line
+			for i += 2; i < len(data) && depth > 0; i++ {
+				switch {
+				case data[i] == '\n':
+					line++
+				case data[i] == '/' && i+1 < len(data) && data[i+1] == '*':
+					depth++
+					i++
+				case data[i] == '*' && i+1 < len(data) && data[i+1] == '/':
+					depth--
+					i++
+				}
+			}
+			if depth > 0 {
+				err = errorf("%d: Unterminated comment", start)
+				return
+			}
+		case c == ':' && i+2 < len(data) && data[i+1] == ':' && data[i+2] == '=':
+			toks = append(toks, asn1Token{text: `::=`, line: line})
+			i += 3
+		case c == '"':
+			// skip character strings, such as
+			// those found within DESCRIPTION
+			// clauses of SMI macros.
+			start := line
+			for i++; i < len(data); i++ {
+				if data[i] == '\n' {
+					line++
+				} else if data[i] == '"' {
+					if i+1 < len(data) && data[i+1] == '"' {
+						i++
+						continue
+					}
+					break
+				}
+			}
+			if i >= len(data) {
+				err = errorf("%d: Unterminated character string", start)
+				return
+			}
+			toks = append(toks, asn1Token{text: `""`, line: start})
+			i++
+		case c < 0x80 && isAlnum(rune(c)):
+			j := i
+			for j < len(data) && ((data[j] < 0x80 && isAlnum(rune(data[j]))) || data[j] == '_' ||
+				(data[j] == '-' && !(j+1 < len(data) && data[j+1] == '-'))) {
+				j++
+			}
+			toks = append(toks, asn1Token{text: string(data[i:j]), line: line})
+			i = j
+		default:
+			toks = append(toks, asn1Token{text: string(c), line: line})
+			i++
+		}
+	}
+
+	return
+}
+
+/*
+asn1Parser interprets the lexical items of one (1) or more ASN.1 modules.
+*/
+type asn1Parser struct {
+	toks   []asn1Token
+	pos    int
+	source string
+}
+
+func (r *asn1Parser) peek(off int) (tok asn1Token) {
+	if i := r.pos + off; 0 <= i && i < len(r.toks) {
+		tok = r.toks[i]
+	}
+	return
+}
+
+func (r *asn1Parser) next() (tok asn1Token) {
+	tok = r.peek(0)
+	r.pos++
+	return
+}
+
+func (r *asn1Parser) errorf(tok asn1Token, msg string, x ...any) error {
+	return errorf("%s:%d: %s", r.source, tok.line, sprintf(msg, x...))
+}
+
+/*
+modules reads all modules from the receiver's lexical items.
+*/
+func (r *asn1Parser) modules() (mods []*asn1Module, err error) {
+	for r.pos < len(r.toks) && err == nil {
+		var mod *asn1Module
+		if mod, err = r.module(); err == nil && mod != nil {
+			mods = append(mods, mod)
+		}
+	}
+
+	if err == nil && len(mods) == 0 {
+		err = errorf("%s: No ASN.1 module definitions found", r.source)
+	}
+
+	return
+}
+
+/*
+module reads a single module definition:
+
+	ModuleName [{ ... }] DEFINITIONS ... ::= BEGIN ... END
+*/
+func (r *asn1Parser) module() (mod *asn1Module, err error) {
+	// seek the DEFINITIONS keyword, noting the
+	// module reference which precedes it.
+	var name asn1Token
+	for r.pos < len(r.toks) && r.peek(0).text != `DEFINITIONS` {
+		tok := r.next()
+		if tok.text == `{` {
+			r.skipBraces()
+		} else if len(tok.text) > 0 && isUpper(rune(tok.text[0])) {
+			name = tok
+		}
+	}
+
+	if r.pos >= len(r.toks) {
+		return
+	} else if name.text == `` {
+		err = r.errorf(r.peek(0), "DEFINITIONS without module reference")
+		return
+	}
+
+	for r.pos < len(r.toks) && !(r.peek(0).text == `::=` && r.peek(1).text == `BEGIN`) {
+		r.pos++
+	}
+	if r.pos >= len(r.toks) {
+		err = r.errorf(name, "Module '%s' lacks '::= BEGIN'", name.text)
+		return
+	}
+	r.pos += 2
+
+	mod = &asn1Module{
+		name:        name.text,
+		source:      r.source,
+		imports:     make(map[string]string),
+		assignments: make(map[string]*rawAssignment),
+	}
+
+	for err == nil {
+		tok := r.peek(0)
+		switch {
+		case r.pos >= len(r.toks):
+			err = r.errorf(name, "Module '%s' lacks END", name.text)
+		case tok.text == `END`:
+			r.pos++
+			return
+		case tok.text == `IMPORTS`:
+			r.pos++
+			err = r.imports(mod)
+		case tok.text == `{`:
+			r.pos++
+			r.skipBraces()
+		case r.peek(1).text == `MACRO`:
+			err = r.skipMacro(tok)
+		case r.peek(1).text == `OBJECT` && r.peek(2).text == `IDENTIFIER` &&
+			r.peek(3).text == `::=` && isIdentifier(tok.text):
+			r.pos += 4
+			err = r.assignment(mod, tok, `OBJECT IDENTIFIER`)
+		case isIdentifier(tok.text) && strInSlice(r.peek(1).text, smiMacros):
+			r.pos += 2
+			err = r.macroAssignment(mod, tok, r.peek(-1).text)
+		default:
+			r.pos++
+		}
+	}
+
+	return
+}
+
+/*
+imports reads the IMPORTS clause, up to and including the closing
+semicolon:
+
+	IMPORTS a, b FROM ModuleA { ... } c FROM ModuleB ;
+*/
+func (r *asn1Parser) imports(mod *asn1Module) (err error) {
+	var symbols []string
+	for {
+		tok := r.next()
+		switch {
+		case r.pos > len(r.toks):
+			err = r.errorf(tok, "Unterminated IMPORTS in module '%s'", mod.name)
+			return
+		case tok.text == `;`:
+			return
+		case tok.text == `,`:
+		case tok.text == `{`:
+			// parameterized reference, e.g.: "Type{}"
+			r.skipBraces()
+		case tok.text == `FROM`:
+			from := r.next()
+			for _, sym := range symbols {
+				mod.imports[sym] = from.text
+			}
+			symbols = nil
+
+			// optional AssignedIdentifier, which is either
+			// an OID value or a value reference which isn't
+			// itself followed by a "," or FROM.
+			if r.peek(0).text == `{` {
+				r.pos++
+				r.skipBraces()
+			} else if nxt := r.peek(1).text; isIdentifier(r.peek(0).text) &&
+				nxt != `,` && nxt != `FROM` && nxt != `{` {
+				r.pos++
+			}
+		default:
+			symbols = append(symbols, tok.text)
+		}
+	}
+}
+
+/*
+assignment reads the components of an OBJECT IDENTIFIER value, following
+the "::=" token:
+
+	{ iso(1) identified-organization(3) dod 6 }
+	{ id-pkix 1 }
+*/
+func (r *asn1Parser) assignment(mod *asn1Module, name asn1Token, kind string) (err error) {
+	if tok := r.next(); tok.text != `{` {
+		err = r.errorf(tok, "Expected '{' to begin value of '%s'", name.text)
+		return
+	}
+
+	raw := &rawAssignment{name: name.text, kind: kind, module: mod, line: name.line}
+	for err == nil {
+		tok := r.next()
+		switch {
+		case r.pos > len(r.toks):
+			err = r.errorf(name, "Unterminated value of '%s'", name.text)
+		case tok.text == `}`:
+			if len(raw.components) == 0 {
+				err = r.errorf(tok, "Empty value of '%s'", name.text)
+				break
+			}
+			if _, dup := mod.assignments[name.text]; dup {
+				err = r.errorf(name, "Duplicate assignment '%s' in module '%s'", name.text, mod.name)
+				break
+			}
+			mod.assignments[name.text] = raw
+			mod.order = append(mod.order, name.text)
+			return
+		case isNumber(tok.text):
+			tok.number, tok.text = tok.text, ``
+			raw.components = append(raw.components, tok)
+		case isIdentifier(tok.text) && r.peek(0).text == `(`:
+			num, closing := r.peek(1), r.peek(2)
+			if !isNumber(num.text) || closing.text != `)` {
+				err = r.errorf(tok, "Unsupported NumberForm for arc '%s' of '%s'", tok.text, name.text)
+				break
+			}
+			r.pos += 3
+			tok.number = num.text
+			raw.components = append(raw.components, tok)
+		case isIdentifier(tok.text):
+			raw.components = append(raw.components, tok)
+		default:
+			err = r.errorf(tok, "Unexpected '%s' within value of '%s'", tok.text, name.text)
+		}
+	}
+
+	return
+}
+
+/*
+smiMacros contains the names of SNMP SMIv1 and SMIv2 macros whose
+values are OBJECT IDENTIFIERs (or, in the case of TRAP-TYPE, an
+INTEGER, which is skipped).
+*/
+var smiMacros []string = []string{
+	`OBJECT-TYPE`,
+	`MODULE-IDENTITY`,
+	`OBJECT-IDENTITY`,
+	`NOTIFICATION-TYPE`,
+	`OBJECT-GROUP`,
+	`NOTIFICATION-GROUP`,
+	`MODULE-COMPLIANCE`,
+	`AGENT-CAPABILITIES`,
+	`TRAP-TYPE`,
+}
+
+/*
+macroAssignment reads an SMI macro value assignment, following the
+macro name, skipping all clauses up to the "::=" token:
+
+	ifIndex OBJECT-TYPE
+	    SYNTAX      InterfaceIndex
+	    MAX-ACCESS  read-only
+	    ...
+	    ::= { ifEntry 1 }
+
+Values which are not OBJECT IDENTIFIERs, such as those of SMIv1
+TRAP-TYPE macros, are skipped.
+*/
+func (r *asn1Parser) macroAssignment(mod *asn1Module, name asn1Token, kind string) (err error) {
+	for r.pos < len(r.toks) && r.peek(0).text != `::=` {
+		switch r.next().text {
+		case `{`:
+			r.skipBraces()
+		case `END`:
+			r.pos = len(r.toks)
+		}
+	}
+
+	if r.pos >= len(r.toks) {
+		err = r.errorf(name, "%s '%s' lacks '::='", kind, name.text)
+	} else if r.pos++; r.peek(0).text == `{` {
+		err = r.assignment(mod, name, kind)
+	}
+
+	return
+}
+
+/*
+skipMacro advances beyond a MACRO definition, such as those found
+within the SNMPv2-SMI module:
+
+	OBJECT-TYPE MACRO ::= BEGIN ... END
+*/
+func (r *asn1Parser) skipMacro(name asn1Token) (err error) {
+	for r.pos < len(r.toks) {
+		if r.next().text == `END` {
+			return
+		}
+	}
+
+	err = r.errorf(name, "MACRO '%s' lacks END", name.text)
+
+	return
+}
+
+/*
+skipBraces advances beyond the closing brace matching one which was
+just read.
+*/
+func (r *asn1Parser) skipBraces() {
+	for depth := 1; depth > 0 && r.pos < len(r.toks); r.pos++ {
+		switch r.toks[r.pos].text {
+		case `{`:
+			depth++
+		case `}`:
+			depth--
+		}
+	}
+}
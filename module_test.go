@@ -0,0 +1,364 @@
+package objectid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const testPKIXModule = `PKIX1Explicit88 { iso(1) identified-organization(3) dod(6)
+  internet(1) security(5) mechanisms(5) pkix(7) id-mod(0) id-pkix1-explicit(18) }
+
+DEFINITIONS EXPLICIT TAGS ::=
+
+BEGIN
+
+-- EXPORTS ALL --
+
+id-pkix  OBJECT IDENTIFIER  ::=
+         { iso(1) identified-organization(3) dod(6) internet(1)
+                    security(5) mechanisms(5) pkix(7) }
+
+/* nested /* block */ comment */
+id-pe OBJECT IDENTIFIER ::= { id-pkix 1 } -- arc for private certificate extensions
+id-qt OBJECT IDENTIFIER ::= { id-pkix 2 }
+id-ad OBJECT IDENTIFIER ::= { id-pkix 48 }
+
+Version ::= INTEGER { v1(0), v2(1), v3(2) }
+
+id-ad-ocsp OBJECT IDENTIFIER ::= { id-ad 1 }
+
+END
+`
+
+const testImportingModule = `ExampleModule { 2 999 1 }
+DEFINITIONS ::=
+BEGIN
+
+IMPORTS
+  id-pe, id-ad FROM PKIX1Explicit88 { iso(1) identified-organization(3) dod(6)
+    internet(1) security(5) mechanisms(5) pkix(7) id-mod(0) id-pkix1-explicit(18) }
+  Name FROM PKIX1Implicit88 ;
+
+id-pe-example OBJECT IDENTIFIER ::= { id-pe 99 }
+id-example OBJECT IDENTIFIER ::= { joint-iso-itu-t example(999) 1 }
+
+END
+`
+
+func ExampleParseModule() {
+	ids, err := ParseModule(strings.NewReader(testPKIXModule))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, id := range ids {
+		fmt.Printf("%s %s\n", id.Name(), id.OID().Dot())
+	}
+	// Output:
+	// id-pkix 1.3.6.1.5.5.7
+	// id-pe 1.3.6.1.5.5.7.1
+	// id-qt 1.3.6.1.5.5.7.2
+	// id-ad 1.3.6.1.5.5.7.48
+	// id-ad-ocsp 1.3.6.1.5.5.7.48.1
+}
+
+func ExampleModuleSet_Resolve() {
+	set := NewModuleSet()
+
+	// modules may be parsed in any order
+	if err := set.Parse(`example.asn`, strings.NewReader(testImportingModule)); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := set.Parse(`pkix.asn`, strings.NewReader(testPKIXModule)); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	if _, err := set.Resolve(); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	id, _ := set.Lookup(`ExampleModule`, `id-pe-example`)
+	fmt.Printf("%s", id)
+	// Output: id-pe-example OBJECT IDENTIFIER ::= {iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7) 1 99}
+}
+
+func TestModuleSet(t *testing.T) {
+	set := NewModuleSet()
+	for name, src := range map[string]string{
+		`pkix.asn`:    testPKIXModule,
+		`example.asn`: testImportingModule,
+	} {
+		if err := set.Parse(name, strings.NewReader(src)); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		}
+	}
+
+	ids, err := set.Resolve()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if len(ids) != 7 {
+		t.Errorf("%s failed: want 7 assignments, got %d", t.Name(), len(ids))
+		return
+	}
+
+	id, ok := set.Lookup(`ExampleModule`, `id-example`)
+	if !ok {
+		t.Errorf("%s failed: id-example not found", t.Name())
+		return
+	}
+	if got := id.OID().Dot().String(); got != `2.999.1` {
+		t.Errorf("%s failed: want 2.999.1, got %s", t.Name(), got)
+	}
+	if id.Module() != `ExampleModule` || id.Line() != 11 {
+		t.Errorf("%s failed: unexpected origin %s:%d", t.Name(), id.Module(), id.Line())
+	}
+
+	// the leaf arcs of plain OBJECT IDENTIFIER
+	// assignments are not named after them.
+	if id, ok = set.Lookup(`PKIX1Explicit88`, `id-pe`); !ok {
+		t.Errorf("%s failed: id-pe not found", t.Name())
+	} else if leaf := id.ASN().Leaf(); leaf.Identifier() != `` || leaf.String() != `1` {
+		t.Errorf("%s failed: want unnamed leaf 1, got %s", t.Name(), leaf)
+	}
+
+	// imported symbols are not defined locally
+	if _, ok = set.Lookup(`ExampleModule`, `id-pe`); ok {
+		t.Errorf("%s failed: imported symbol found within importing module", t.Name())
+	}
+
+	// duplicate modules are refused
+	if err = set.Parse(`again.asn`, strings.NewReader(testPKIXModule)); err == nil {
+		t.Errorf("%s failed: duplicate module accepted", t.Name())
+	}
+}
+
+func TestModuleSet_resolver(t *testing.T) {
+	src := `M DEFINITIONS ::= BEGIN
+id-ent OBJECT IDENTIFIER ::= { iso identified-organization(3) dod internet private enterprise }
+END`
+
+	if _, err := ParseModule(strings.NewReader(src)); err == nil {
+		t.Errorf("%s failed: name-only arcs resolved without resolver", t.Name())
+	}
+
+	ids, err := ParseModule(strings.NewReader(src), NewWellKnownRegistry())
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if got := ids[0].OID().Dot().String(); got != `1.3.6.1.4.1` {
+		t.Errorf("%s failed: want 1.3.6.1.4.1, got %s", t.Name(), got)
+	}
+}
+
+func TestModuleSet_errors(t *testing.T) {
+	for idx, test := range []struct {
+		src  string
+		want string
+	}{
+		{`M DEFINITIONS ::= BEGIN
+id-a OBJECT IDENTIFIER ::= { id-c 1 }
+id-b OBJECT IDENTIFIER ::= { id-a 1 }
+id-c OBJECT IDENTIFIER ::= { id-b 1 }
+END`, `input:2: Cyclic reference: id-a -> id-c -> id-b -> id-a`},
+		{`M DEFINITIONS ::= BEGIN
+
+id-a OBJECT IDENTIFIER ::= { id-undefined 1 }
+END`, `input:3: Undefined reference 'id-undefined'`},
+		{`M DEFINITIONS ::= BEGIN
+IMPORTS id-x FROM Missing;
+id-a OBJECT IDENTIFIER ::= { id-x 1 }
+END`, `input:3: 'id-x' imported from module 'Missing', which has not been parsed`},
+		{`M DEFINITIONS ::= BEGIN
+id-a OBJECT IDENTIFIER ::= { 1 3 }
+id-a OBJECT IDENTIFIER ::= { 1 4 }
+END`, `input:3: Duplicate assignment 'id-a' in module 'M'`},
+		{`M DEFINITIONS ::= BEGIN
+id-a OBJECT IDENTIFIER ::= { 3 1 }
+END`, `input:2: Invalid OBJECT IDENTIFIER value`},
+		{`M DEFINITIONS ::= BEGIN
+id-a OBJECT IDENTIFIER ::= { 1 3
+END`, `input:3: Unexpected 'END'`},
+		{`M DEFINITIONS ::= BEGIN
+id-a OBJECT IDENTIFIER ::= { 1 3 }`, `input:1: Module 'M' lacks END`},
+		{`M DEFINITIONS ::= BEGIN /* unterminated
+END`, `input:1: Unterminated comment`},
+		{`-- nothing here`, `input: No ASN.1 module definitions found`},
+	} {
+		_, err := ParseModule(strings.NewReader(test.src))
+		if err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+		} else if !hasPrefix(err.Error(), test.want) {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %v", t.Name(), idx, test.want, err)
+		}
+	}
+}
+
+const testSNMPv2SMI = `SNMPv2-SMI DEFINITIONS ::= BEGIN
+
+-- the path to the root
+
+org            OBJECT IDENTIFIER ::= { iso 3 }  --  "iso" = 1
+dod            OBJECT IDENTIFIER ::= { org 6 }
+internet       OBJECT IDENTIFIER ::= { dod 1 }
+mgmt           OBJECT IDENTIFIER ::= { internet 2 }
+mib-2          OBJECT IDENTIFIER ::= { mgmt 1 }
+
+-- definitions for information modules
+
+MODULE-IDENTITY MACRO ::=
+BEGIN
+    TYPE NOTATION ::=
+                  "LAST-UPDATED" value(Update ExtUTCTime)
+                  "ORGANIZATION" Text
+    VALUE NOTATION ::=
+                  value(VALUE OBJECT IDENTIFIER)
+    Text ::= value(IA5String)
+END
+
+OBJECT-TYPE MACRO ::=
+BEGIN
+    TYPE NOTATION ::=
+                  "SYNTAX" Syntax
+    VALUE NOTATION ::=
+                  value(VALUE ObjectName)
+END
+
+END
+`
+
+const testIFMIB = `IF-MIB DEFINITIONS ::= BEGIN
+
+IMPORTS
+    MODULE-IDENTITY, OBJECT-TYPE, mib-2,
+    Integer32                             FROM SNMPv2-SMI
+    DisplayString                         FROM SNMPv2-TC;
+
+ifMIB MODULE-IDENTITY
+    LAST-UPDATED "200006140000Z"
+    ORGANIZATION "IETF Interfaces MIB Working Group"
+    DESCRIPTION
+            "The MIB module to describe generic objects for network
+            interface sub-layers.  ::= { bogus 1 }"
+    REVISION      "200006140000Z"
+    DESCRIPTION
+            "Clarifications agreed upon by the Interfaces MIB WG."
+    ::= { mib-2 31 }
+
+interfaces   OBJECT IDENTIFIER ::= { mib-2 2 }
+
+ifTable OBJECT-TYPE
+    SYNTAX      SEQUENCE OF IfEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    DESCRIPTION
+            "A list of interface entries."
+    ::= { interfaces 2 }
+
+ifEntry OBJECT-TYPE
+    SYNTAX      IfEntry
+    MAX-ACCESS  not-accessible
+    STATUS      current
+    INDEX   { ifIndex }
+    ::= { ifTable 1 }
+
+IfEntry ::=
+    SEQUENCE {
+        ifIndex                 InterfaceIndex,
+        ifAdminStatus           INTEGER
+    }
+
+ifAdminStatus OBJECT-TYPE
+    SYNTAX  INTEGER {
+                up(1),       -- ready to pass packets
+                down(2),
+                testing(3)   -- in some test mode
+            }
+    MAX-ACCESS  read-write
+    STATUS      current
+    ::= { ifEntry 7 }
+
+linkDown NOTIFICATION-TYPE
+    OBJECTS { ifIndex, ifAdminStatus }
+    STATUS  current
+    ::= { snmpTraps 3 }
+
+snmpTraps OBJECT-IDENTITY
+    STATUS  current
+    DESCRIPTION "Traps."
+    ::= { ifMIB 99 }
+
+END
+`
+
+func ExampleModuleSet_smi() {
+	set := NewModuleSet()
+	_ = set.Parse(`SNMPv2-SMI`, strings.NewReader(testSNMPv2SMI))
+	_ = set.Parse(`IF-MIB`, strings.NewReader(testIFMIB))
+
+	ids, err := set.Resolve()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, id := range ids {
+		if id.Module() == `IF-MIB` {
+			fmt.Printf("%s %s %s\n", id.Kind(), id.OID().Dot(), id.ASN().Leaf())
+		}
+	}
+	// Output:
+	// MODULE-IDENTITY 1.3.6.1.2.1.31 ifMIB(31)
+	// OBJECT IDENTIFIER 1.3.6.1.2.1.2 2
+	// OBJECT-TYPE 1.3.6.1.2.1.2.2 ifTable(2)
+	// OBJECT-TYPE 1.3.6.1.2.1.2.2.1 ifEntry(1)
+	// OBJECT-TYPE 1.3.6.1.2.1.2.2.1.7 ifAdminStatus(7)
+	// NOTIFICATION-TYPE 1.3.6.1.2.1.31.99.3 linkDown(3)
+	// OBJECT-IDENTITY 1.3.6.1.2.1.31.99 snmpTraps(99)
+}
+
+func TestModuleSet_smi(t *testing.T) {
+	set := NewModuleSet()
+	for _, src := range []string{testSNMPv2SMI, testIFMIB} {
+		if err := set.Parse(`mib`, strings.NewReader(src)); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		}
+	}
+
+	if _, err := set.Resolve(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	id, ok := set.Lookup(`IF-MIB`, `ifAdminStatus`)
+	if !ok {
+		t.Errorf("%s failed: ifAdminStatus not found", t.Name())
+		return
+	}
+
+	want := `{iso(1) 3 6 1 2 1 2 ifTable(2) ifEntry(1) ifAdminStatus(7)}`
+	if got := id.ASN().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+	}
+
+	// SMIv1 TRAP-TYPE values are INTEGERs, not OIDs
+	v1 := `RFC1215-EXAMPLE DEFINITIONS ::= BEGIN
+myEnterprise OBJECT IDENTIFIER ::= { 1 3 6 1 4 1 56521 }
+myTrap TRAP-TYPE
+    ENTERPRISE myEnterprise
+    VARIABLES { ifIndex }
+    ::= 3
+END`
+	ids, err := ParseModule(strings.NewReader(v1))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if len(ids) != 1 || ids[0].Name() != `myEnterprise` {
+		t.Errorf("%s failed: unexpected assignments %v", t.Name(), ids)
+	}
+}
@@ -12,9 +12,12 @@ decimal value, or a decimal value alone. An ordered sequence of instances of
 this type comprise an instance of [ASN1Notation].
 */
 type NameAndNumberForm struct {
-	identifier        string
-	primaryIdentifier NumberForm
-	parsed            bool
+	identifier           string
+	secondaryIdentifiers []string
+	unicodeLabels        []string
+	primaryIdentifier    NumberForm
+	longArc              bool
+	parsed               bool
 }
 
 /*
@@ -33,6 +36,157 @@ func (r NameAndNumberForm) Identifier() string {
 	return r.identifier
 }
 
+/*
+SecondaryIdentifiers returns slices of the secondary identifiers assigned
+to the receiver instance, per ITU-T Rec. X.660. These do not include the
+(primary) identifier returned by [NameAndNumberForm.Identifier].
+*/
+func (r NameAndNumberForm) SecondaryIdentifiers() (ids []string) {
+	if len(r.secondaryIdentifiers) > 0 {
+		ids = make([]string, len(r.secondaryIdentifiers))
+		copy(ids, r.secondaryIdentifiers)
+	}
+	return
+}
+
+/*
+SetSecondaryIdentifiers assigns the input secondary identifiers to the
+receiver instance, replacing any previously assigned. Each identifier
+must qualify as an ASN.1 identifier (see [IsIdentifier]), and may not
+duplicate the primary identifier or another secondary identifier.
+
+An error, wrapping [ErrInvalidIdentifier] or [ErrDuplicateIdentifier]
+within a *[ParseError], is returned if any identifier is invalid, in which
+case the receiver instance is not modified.
+*/
+func (r *NameAndNumberForm) SetSecondaryIdentifiers(ids ...string) (err error) {
+	var _ids []string
+	for i := 0; i < len(ids); i++ {
+		if !isIdentifier(ids[i]) {
+			err = parseErr(ErrInvalidIdentifier, ids[i], -1, -1)
+			return
+		} else if ids[i] == r.identifier || strInSlice(ids[i], _ids) {
+			err = parseErr(ErrDuplicateIdentifier, ids[i], -1, -1)
+			return
+		}
+		_ids = append(_ids, ids[i])
+	}
+
+	r.secondaryIdentifiers = _ids
+
+	return
+}
+
+/*
+UnicodeLabels returns slices of the Unicode labels assigned to the receiver
+instance, per ITU-T Rec. X.660. The first non-integer label, if present, is
+considered the preferred label when rendering an [IRINotation].
+*/
+func (r NameAndNumberForm) UnicodeLabels() (labels []string) {
+	if len(r.unicodeLabels) > 0 {
+		labels = make([]string, len(r.unicodeLabels))
+		copy(labels, r.unicodeLabels)
+	}
+	return
+}
+
+/*
+SetUnicodeLabels assigns the input Unicode labels to the receiver instance,
+replacing any previously assigned. Each label must be drawn from the X.660
+character repertoire and qualify as either:
+
+  - an integer label, which must equal the receiver's [NumberForm], or ...
+  - a non-integer label (e.g.: "Example" or "Ünïcödé")
+
+Labels may not be duplicated. An error, wrapping [ErrInvalidLabel],
+[ErrLabelMismatch], [ErrDuplicateLabel] or [ErrLongArcLabel] within a
+*[ParseError], is returned if any label is invalid, in which case the
+receiver instance is not modified. Constraints which depend upon the
+position of the receiver within an [ASN1Notation] are not verified here;
+see [NameAndNumberForm.SetLongArc].
+*/
+func (r *NameAndNumberForm) SetUnicodeLabels(labels ...string) (err error) {
+	var _labels []string
+	for i := 0; i < len(labels); i++ {
+		switch label := labels[i]; {
+		case isIntegerLabel(label):
+			if !r.primaryIdentifier.Equal(label) {
+				err = parseErr(ErrLabelMismatch, label, -1, -1)
+				return
+			}
+		case !isNonIntegerLabel(label):
+			err = parseErr(ErrInvalidLabel, label, -1, -1)
+			return
+		}
+
+		if strInSlice(labels[i], _labels) {
+			err = parseErr(ErrDuplicateLabel, labels[i], -1, -1)
+			return
+		}
+		_labels = append(_labels, labels[i])
+	}
+
+	if r.longArc && !(NameAndNumberForm{unicodeLabels: _labels}).hasNonIntegerLabel() {
+		err = parseErr(ErrLongArcLabel, r.String(), -1, -1)
+		return
+	}
+
+	r.unicodeLabels = _labels
+
+	return
+}
+
+/*
+LongArc returns a Boolean value indicative of whether the receiver instance
+has been designated as a long arc, per ITU-T Rec. X.660.
+*/
+func (r NameAndNumberForm) LongArc() bool {
+	return r.longArc
+}
+
+/*
+SetLongArc designates the receiver instance as a long arc, or removes said
+designation, based upon the input Boolean value. A long arc allows for the
+arc to be referenced directly from the root within an [IRINotation] (e.g.:
+"/Example" instead of "/Joint-ISO-ITU-T/Example"), and requires one (1) or
+more non-integer Unicode labels; otherwise, an error wrapping [ErrLongArcLabel]
+within a *[ParseError] is returned.
+
+Only constraints local to the receiver instance are verified here. Long arcs
+are only permitted directly beneath joint-iso-itu-t(2), which depends upon
+the position of the receiver within an [ASN1Notation]; this is verified by
+the [ASN1Notation.Valid] and [OID.Valid] methods, and enforced by [NewOID]
+and [NewASN1Notation], but not when an [ASN1Notation] is assembled directly.
+*/
+func (r *NameAndNumberForm) SetLongArc(long bool) (err error) {
+	if long && !r.hasNonIntegerLabel() {
+		err = parseErr(ErrLongArcLabel, r.String(), -1, -1)
+		return
+	}
+	r.longArc = long
+
+	return
+}
+
+func (r NameAndNumberForm) hasNonIntegerLabel() bool {
+	_, ok := r.nonIntegerLabel()
+	return ok
+}
+
+/*
+nonIntegerLabel returns the first non-integer Unicode label assigned
+to the receiver instance, alongside a Boolean value indicative of
+success.
+*/
+func (r NameAndNumberForm) nonIntegerLabel() (label string, ok bool) {
+	for i := 0; i < len(r.unicodeLabels) && !ok; i++ {
+		if ok = !isIntegerLabel(r.unicodeLabels[i]); ok {
+			label = r.unicodeLabels[i]
+		}
+	}
+	return
+}
+
 /*
 NumberForm returns the underlying [NumberForm]
 value assigned to the receiver instance.
@@ -79,8 +233,14 @@ func parseRootNameOnly(x string) (r *NameAndNumberForm, err error) {
 		root = big.NewInt(1)
 	case `joint-iso-itu-t`:
 		root = big.NewInt(2)
+	case ``:
+		err = parseErr(ErrEmpty, x, -1, -1)
 	default:
-		err = errorf("Unknown root abbreviation, or no closing NumberForm parenthesis to read")
+		if isIdentifier(x) {
+			err = parseErr(ErrUnresolvedArc, x, -1, 0)
+		} else {
+			err = parseErr(ErrInvalidSyntax, x, -1, len(x)-1)
+		}
 	}
 
 	if err == nil {
@@ -96,11 +256,12 @@ func parseRootNameOnly(x string) (r *NameAndNumberForm, err error) {
 
 /*
 parseNaNFstr returns an instance of *[NameAndNumberForm] alongside an error.
+If lenient is true, the number form may bear leading zeros.
 */
-func parseNaNFstr(x string) (r *NameAndNumberForm, err error) {
+func parseNaNFstr(x string, lenient bool) (r *NameAndNumberForm, err error) {
 	// Don't waste time on bogus values.
 	if len(x) == 0 {
-		err = errorf("No content for parseNaNFstr to read")
+		err = parseErr(ErrEmpty, x, -1, -1)
 		return
 	} else if x[len(x)-1] != ')' {
 		r, err = parseRootNameOnly(x)
@@ -112,29 +273,24 @@ func parseNaNFstr(x string) (r *NameAndNumberForm, err error) {
 	// numberForm is beginning.
 	idx := indexRune(x, '(')
 	if idx == -1 {
-		err = errorf("No opening parenthesis for parseNaNFstr to read")
+		err = parseErr(ErrInvalidSyntax, x, -1, len(x)-1)
 		return
 	}
 
-	// select the numerical characters,
-	// or bail out ...
-	n := x[idx+1 : len(x)-1]
-	if !isNumber(n) {
-		err = errorf("Bad numberForm")
-		return
-	}
 	// Parse/verify what appears to be the
 	// identifier string value.
 	var identifier string = x[:idx]
 	if !isIdentifier(identifier) {
-		err = errorf("Invalid identifier [%s]; syntax must conform to: LOWER *[ [-] +[ UPPER / LOWER / DIGIT ] ]", identifier)
+		err = parseErr(ErrInvalidIdentifier, x, -1, 0)
 		return
 	}
 
 	// parse the string numberForm value into
 	// an instance of NumberForm, or bail out.
 	var prid NumberForm
-	if prid, err = NewNumberForm(n); err == nil {
+	if prid, err = newNumberForm(x[idx+1:len(x)-1], lenient); err != nil {
+		err = reparse(err, x, -1, idx+1)
+	} else {
 		// Prepare to return valid information.
 		r = new(NameAndNumberForm)
 		r.parsed = true
@@ -145,14 +301,14 @@ func parseNaNFstr(x string) (r *NameAndNumberForm, err error) {
 	return
 }
 
-func parseNaNFOrNF(tv string) (r *NameAndNumberForm, err error) {
+func parseNaNFOrNF(tv string, lenient bool) (r *NameAndNumberForm, err error) {
 	r = new(NameAndNumberForm)
 
 	if !isNumber(tv) {
-		r, err = parseNaNFstr(tv)
+		r, err = parseNaNFstr(tv, lenient)
 	} else {
 		var a NumberForm
-		if a, err = NewNumberForm(tv); err == nil {
+		if a, err = newNumberForm(tv, lenient); err == nil {
 			r = &NameAndNumberForm{primaryIdentifier: a}
 		}
 	}
@@ -163,13 +319,11 @@ func parseNaNFOrNF(tv string) (r *NameAndNumberForm, err error) {
 func parseNaNFBig(tv *big.Int) (r *NameAndNumberForm, err error) {
 	r = new(NameAndNumberForm)
 
-	if tv.Int64() < 0 {
-		err = errorf("NameAndNumberForm cannot contain a negative NumberForm")
+	if tv == nil || len(tv.Bytes()) == 0 {
+		err = parseErr(ErrEmpty, sprintf("%v", tv), -1, -1)
 		return
-	}
-
-	if len(tv.Bytes()) == 0 {
-		err = errorf("NumberForm (%T) is nil", tv)
+	} else if tv.Sign() < 0 {
+		err = parseErr(ErrNegative, tv.String(), -1, 0)
 		return
 	}
 
@@ -188,12 +342,14 @@ alongside an error. Valid input forms are:
 
 [NumberForm] components CANNOT be negative. Permitted input types are
 string, uint, uint64, [NumberForm], *[math/big.Int] and (non-negative) int.
+
+Any error returned is a *[ParseError].
 */
 func NewNameAndNumberForm(x any) (r *NameAndNumberForm, err error) {
 
 	switch tv := x.(type) {
 	case string:
-		r, err = parseNaNFOrNF(tv)
+		r, err = parseNaNFOrNF(tv, false)
 	case *big.Int:
 		r, err = parseNaNFBig(tv)
 	case NumberForm:
@@ -209,12 +365,12 @@ func NewNameAndNumberForm(x any) (r *NameAndNumberForm, err error) {
 	case int:
 		r = new(NameAndNumberForm)
 		if tv < 0 {
-			err = errorf("NumberForm cannot be negative")
+			err = parseErr(ErrNegative, sprintf("%d", tv), -1, 0)
 			break
 		}
 		r, err = NewNameAndNumberForm(uint64(tv))
 	default:
-		err = errorf("Unsupported %T input type '%T'", r, tv)
+		err = parseErr(ErrUnsupportedType, sprintf("%T", tv), -1, -1)
 	}
 
 	// mark this instance as complete,
@@ -1,6 +1,7 @@
 package objectid
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -127,7 +128,7 @@ func TestParseNaNFStr(t *testing.T) {
 		`cn(3)`,
 		`identifier(-3)`,
 	} {
-		_, err := parseNaNFstr(slice)
+		_, err := parseNaNFstr(slice, false)
 		if err != nil {
 			if idx%2 == 0 {
 				t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
@@ -141,3 +142,130 @@ func TestParseNaNFStr(t *testing.T) {
 		}
 	}
 }
+
+func ExampleNameAndNumberForm_SetUnicodeLabels() {
+	nanf, _ := NewNameAndNumberForm(`example(999)`)
+	if err := nanf.SetUnicodeLabels(`Example`, `999`); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s %v", nanf, nanf.UnicodeLabels())
+	// Output: example(999) [Example 999]
+}
+
+func TestNameAndNumberForm_x660(t *testing.T) {
+	nanf, _ := NewNameAndNumberForm(`example(999)`)
+
+	if err := nanf.SetSecondaryIdentifiers(`sample`, `test`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if ids := nanf.SecondaryIdentifiers(); len(ids) != 2 || nanf.Identifier() != `example` {
+		t.Errorf("%s failed: unexpected identifiers %v", t.Name(), ids)
+		return
+	}
+
+	for _, bogus := range []struct {
+		ids  []string
+		kind error
+	}{
+		{[]string{`Sample`}, ErrInvalidIdentifier},
+		{[]string{`example`}, ErrDuplicateIdentifier},
+		{[]string{`sample`, `sample`}, ErrDuplicateIdentifier},
+	} {
+		if err := nanf.SetSecondaryIdentifiers(bogus.ids...); !errors.Is(err, bogus.kind) {
+			t.Errorf("%s failed: secondary identifiers %v: want %v, got %v", t.Name(), bogus.ids, bogus.kind, err)
+		}
+	}
+
+	for _, bogus := range []struct {
+		labels []string
+		kind   error
+	}{
+		{[]string{`998`}, ErrLabelMismatch},
+		{[]string{`-Example`}, ErrInvalidLabel},
+		{[]string{`Example`, `Example`}, ErrDuplicateLabel},
+		{[]string{`Exa mple`}, ErrInvalidLabel},
+	} {
+		if err := nanf.SetUnicodeLabels(bogus.labels...); !errors.Is(err, bogus.kind) {
+			t.Errorf("%s failed: Unicode labels %v: want %v, got %v", t.Name(), bogus.labels, bogus.kind, err)
+		}
+	}
+
+	if err := nanf.SetLongArc(true); !errors.Is(err, ErrLongArcLabel) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrLongArcLabel, err)
+	}
+
+	_ = nanf.SetUnicodeLabels(`Example`)
+	if err := nanf.SetLongArc(true); err != nil || !nanf.LongArc() {
+		t.Errorf("%s failed: long arc not set: %v", t.Name(), err)
+	} else if err = nanf.SetUnicodeLabels(`999`); !errors.Is(err, ErrLongArcLabel) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrLongArcLabel, err)
+	}
+
+	child, _ := NewNameAndNumberForm(1)
+	_ = child.SetUnicodeLabels(`Ünïcödé`)
+
+	root, _ := NewNameAndNumberForm(`joint-iso-itu-t(2)`)
+	asn, err := NewASN1Notation([]NameAndNumberForm{*root, *nanf, *child})
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := asn.IRI(nil).String(); got != `/Example/Ünïcödé` {
+		t.Errorf("%s failed: want '/Example/Ünïcödé', got '%s'", t.Name(), got)
+	}
+
+	// long arcs are not permitted beneath iso(1)
+	root, _ = NewNameAndNumberForm(`iso(1)`)
+	if _, err = NewOID([]NameAndNumberForm{*root, *nanf}); !errors.Is(err, ErrInvalidLongArc) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidLongArc, err)
+	}
+}
+
+func TestNameAndNumberForm_deferredValidation(t *testing.T) {
+	nanf, _ := NewNameAndNumberForm(`example(999)`)
+
+	// the setters cannot know where the arc will
+	// reside, thus a long arc is accepted here ...
+	if err := nanf.SetUnicodeLabels(`Example`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = nanf.SetLongArc(true); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// ... and verified once it has been placed.
+	root, _ := NewNameAndNumberForm(`iso(1)`)
+	sub := ASN1Notation{*root, *nanf}
+	if sub.Valid() {
+		t.Errorf("%s failed: long arc beneath iso(1) considered valid", t.Name())
+	} else if (OID{nanf: sub, parsed: true}).Valid() {
+		t.Errorf("%s failed: OID bearing long arc beneath iso(1) considered valid", t.Name())
+	} else if _, err := NewASN1Notation([]NameAndNumberForm(sub)); !errors.Is(err, ErrInvalidLongArc) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidLongArc, err)
+	}
+
+	root, _ = NewNameAndNumberForm(`joint-iso-itu-t(2)`)
+	if sub = (ASN1Notation{*root, *nanf}); !sub.Valid() {
+		t.Errorf("%s failed: long arc beneath joint-iso-itu-t(2) considered invalid", t.Name())
+	} else if oid, err := NewOID([]NameAndNumberForm(sub)); err != nil || !oid.Valid() {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+
+	// long arcs must reside directly beneath
+	// joint-iso-itu-t(2), not at any depth.
+	leaf, _ := NewNameAndNumberForm(`sub(5)`)
+	_ = leaf.SetUnicodeLabels(`Sub`)
+	_ = leaf.SetLongArc(true)
+	example, _ := NewNameAndNumberForm(`example(999)`)
+
+	var pe *ParseError
+	if sub = (ASN1Notation{*root, *example, *leaf}); sub.Valid() {
+		t.Errorf("%s failed: nested long arc considered valid", t.Name())
+	} else if (OID{nanf: sub, parsed: true}).Valid() {
+		t.Errorf("%s failed: OID bearing nested long arc considered valid", t.Name())
+	} else if _, err := NewOID([]NameAndNumberForm(sub)); !errors.As(err, &pe) || pe.Kind != ErrInvalidLongArc || pe.Arc != 2 {
+		t.Errorf("%s failed: want %v at arc 2, got %v", t.Name(), ErrInvalidLongArc, err)
+	}
+}
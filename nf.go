@@ -4,12 +4,22 @@ package objectid
 nf.go provides NumberForm methods and types.
 */
 
-import "math/big"
+import (
+	"math/big"
+	"math/bits"
+	"strconv"
+)
 
 var nilNF NumberForm
 
 /*
 NumberForm is an unbounded, unsigned number.
+
+Internally, arcs which fit within a uint64 are handled without recourse to
+[math/big] arithmetic, and those produced by [NewDotNotation] and the ASN.1
+decoder share a single allocation per [DotNotation]. Only arcs of greater
+magnitude, such as those found beneath joint-iso-itu-t(2) uuid(25), incur
+the cost of arbitrary-precision arithmetic.
 */
 type NumberForm big.Int
 
@@ -18,7 +28,7 @@ IsZero returns a Boolean value indicative of whether the
 receiver instance is nil, or unset.
 */
 func (r *NumberForm) IsZero() (is bool) {
-	return len(r.cast().Bytes()) == 0
+	return (*big.Int)(r).Sign() == 0
 }
 
 func (r NumberForm) cast() *big.Int {
@@ -27,39 +37,73 @@ func (r NumberForm) cast() *big.Int {
 }
 
 /*
-Equal returns a boolean value indicative of whether the receiver is equal to
-the value provided.
+small returns the receiver's value as a uint64 alongside a Boolean value
+indicative of whether the value fits.
+*/
+func (r *NumberForm) small() (v uint64, ok bool) {
+	x := (*big.Int)(r)
+	if ok = x.IsUint64(); ok {
+		v = x.Uint64()
+	}
 
-Valid input types are string, uint64, int, uint, *[math/big.Int] and [NumberForm].
+	return
+}
 
-Any input that represents a negative or unspecified number guarantees a false return.
+/*
+compare returns the result of comparing the receiver with n, which may
+be any of the types supported by [NumberForm.Equal], alongside a Boolean
+value indicative of whether n was usable.
 */
-func (r NumberForm) Equal(n any) (is bool) {
+func (r NumberForm) compare(n any) (c int, ok bool) {
+	var u uint64
 	switch tv := n.(type) {
 	case *big.Int:
-		is = r.cast().Cmp(tv) == 0
+		if ok = tv != nil; ok {
+			c = (*big.Int)(&r).Cmp(tv)
+		}
+		return
 	case NumberForm:
-		is = r.cast().Cmp(tv.cast()) == 0
+		c, ok = r.cmp(tv), true
+		return
 	case string:
-		nf, ok := big.NewInt(0).SetString(tv, 10)
-		if !ok {
-			is = ok
-			break
+		var x big.Int
+		if _, ok = x.SetString(tv, 10); ok {
+			c = (*big.Int)(&r).Cmp(&x)
 		}
-		is = r.cast().Cmp(nf) == 0
+		return
 	case uint64:
-		is = r.cast().Uint64() == tv
+		u, ok = tv, true
 	case uint:
-		is = r.cast().Uint64() == uint64(tv)
+		u, ok = uint64(tv), true
 	case int:
-		if 0 <= tv {
-			is = r.cast().Uint64() == uint64(tv)
+		u, ok = uint64(tv), tv >= 0
+	}
+
+	if ok {
+		// the receiver exceeds any uint64
+		// value if it does not fit one.
+		c = 1
+		if v, fits := r.small(); fits {
+			c = cmpUint64(v, u)
 		}
 	}
 
 	return
 }
 
+/*
+Equal returns a boolean value indicative of whether the receiver is equal to
+the value provided.
+
+Valid input types are string, uint64, int, uint, *[math/big.Int] and [NumberForm].
+
+Any input that represents a negative or unspecified number guarantees a false return.
+*/
+func (r NumberForm) Equal(n any) bool {
+	c, ok := r.compare(n)
+	return ok && c == 0
+}
+
 /*
 Gt returns a boolean value indicative of whether the receiver is greater than
 the value provided.
@@ -68,29 +112,9 @@ Valid input types are string, uint64, int, uint, *[math/big.Int] and [NumberForm
 
 Any input that represents a negative or unspecified number guarantees a false return.
 */
-func (r NumberForm) Gt(n any) (is bool) {
-	switch tv := n.(type) {
-	case *big.Int:
-		is = r.cast().Cmp(tv) == 1
-	case NumberForm:
-		is = r.cast().Cmp(tv.cast()) == 1
-	case string:
-		nf, ok := big.NewInt(0).SetString(tv, 10)
-		if !ok {
-			is = ok
-			break
-		}
-		is = r.cast().Cmp(nf) == 1
-	case uint64:
-		is = r.cast().Uint64() > tv
-	case uint:
-		is = r.cast().Uint64() > uint64(tv)
-	case int:
-		if 0 <= tv {
-			is = r.cast().Uint64() > uint64(tv)
-		}
-	}
-	return
+func (r NumberForm) Gt(n any) bool {
+	c, ok := r.compare(n)
+	return ok && c > 0
 }
 
 /*
@@ -116,29 +140,9 @@ Valid input types are string, uint64, int, uint, *[math/big.Int] and [NumberForm
 
 Any input that represents a negative or unspecified number guarantees a false return.
 */
-func (r NumberForm) Lt(n any) (is bool) {
-	switch tv := n.(type) {
-	case *big.Int:
-		is = r.cast().Cmp(tv) == -1
-	case NumberForm:
-		is = r.cast().Cmp(tv.cast()) == -1
-	case string:
-		nf, ok := big.NewInt(0).SetString(tv, 10)
-		if !ok {
-			is = ok
-			break
-		}
-		is = r.cast().Cmp(nf) == -1
-	case uint64:
-		is = r.cast().Uint64() < tv
-	case uint:
-		is = r.cast().Uint64() < uint64(tv)
-	case int:
-		if 0 <= tv {
-			is = r.cast().Uint64() < uint64(tv)
-		}
-	}
-	return
+func (r NumberForm) Lt(n any) bool {
+	c, ok := r.compare(n)
+	return ok && c < 0
 }
 
 /*
@@ -168,26 +172,131 @@ String returns the base-10 string representation of the receiver
 instance.
 */
 func (r NumberForm) String() string {
-	return r.cast().String()
+	if v, ok := r.small(); ok {
+		return strconv.FormatUint(v, 10)
+	}
+
+	return (*big.Int)(&r).String()
+}
+
+/*
+appendText appends the base-10 string representation of the receiver
+to b, returning the extended slice.
+*/
+func (r *NumberForm) appendText(b []byte) []byte {
+	if v, ok := r.small(); ok {
+		return strconv.AppendUint(b, v, 10)
+	}
+
+	return (*big.Int)(r).Append(b, 10)
+}
+
+/*
+appendVLQ appends the VLQ encoding of the receiver to b, returning the
+extended slice.
+*/
+func (r *NumberForm) appendVLQ(b []byte) []byte {
+	if v, ok := r.small(); ok {
+		return appendVLQ(b, v)
+	}
+
+	return append(b, encodeVLQ((*big.Int)(r).Bytes())...)
+}
+
+/*
+arcSlab is contiguous storage for the words of arcs which fit within a
+uint64, allowing the arcs of a [DotNotation] to share one allocation.
+On platforms where a [math/big.Word] is narrower than a uint64, slabs
+are not used.
+*/
+type arcSlab []big.Word
+
+func newArcSlab(n int) (s arcSlab) {
+	if bits.UintSize == 64 {
+		s = make(arcSlab, n)
+	}
+
+	return
 }
 
-func newStringNF(tv string) (nf *big.Int, err error) {
+/*
+numberForm returns a [NumberForm] bearing the value v, backed by the
+next available word of the receiver, if any.
+*/
+func (r *arcSlab) numberForm(v uint64) (n NumberForm) {
+	if len(*r) == 0 {
+		(*big.Int)(&n).SetUint64(v)
+		return
+	}
+
+	// limit capacity, such that the word
+	// cannot be extended into its neighbor.
+	w := (*r)[:1:1]
+	*r = (*r)[1:]
+	w[0] = big.Word(v)
+	(*big.Int)(&n).SetBits(w)
+
+	return
+}
+
+/*
+parseNF returns a [NumberForm] alongside an error following an attempt to
+parse the input string. Values which fit within a uint64 are backed by
+slab, if non-nil. If lenient is true, leading zeros are permitted.
+*/
+func parseNF(tv string, slab *arcSlab, lenient bool) (nf NumberForm, err error) {
 	if len(tv) == 0 {
-		err = errorf("Zero length NumberForm %T", tv)
+		err = parseErr(ErrEmpty, tv, -1, -1)
 		return
 	} else if tv[0] == '-' {
-		err = errorf("A NumberForm cannot be negative")
+		err = parseErr(ErrNegative, tv, -1, 0)
 		return
 	}
 
-	var ok bool
-	if nf, ok = big.NewInt(0).SetString(tv, 10); !ok {
-		err = errorf("Failed to read '%s' into NumberForm", tv)
+	for i := 0; i < len(tv); i++ {
+		if !('0' <= tv[i] && tv[i] <= '9') {
+			err = parseErr(ErrInvalidNumber, tv, -1, i)
+			return
+		}
+	}
+
+	if !isCanonicalNumber(tv) && !lenient {
+		err = parseErr(ErrLeadingZero, tv, -1, 0)
+		return
+	}
+
+	// nineteen (19) decimal digits always fit a uint64.
+	if len(tv) <= 19 {
+		v, _ := strconv.ParseUint(tv, 10, 64)
+		if slab == nil {
+			slab = new(arcSlab)
+		}
+		nf = slab.numberForm(v)
+	} else {
+		(*big.Int)(&nf).SetString(tv, 10)
 	}
 
 	return
 }
 
+/*
+isCanonicalNumber returns a Boolean value indicative of whether val is
+a string of ASCII decimal digits bearing no leading zeros.
+*/
+func isCanonicalNumber(val string) bool {
+	if len(val) == 0 || (val[0] == '0' && len(val) > 1) {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		if !('0' <= val[i] && val[i] <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
 /*
 NewNumberForm converts v into an instance of [NumberForm], which is
 returned alongside an error.
@@ -195,33 +304,83 @@ returned alongside an error.
 Valid input types are string, uint64, int, uint, and *[math/big.Int].
 
 Any input that represents a negative or unspecified number guarantees an error.
+String input must be canonical: it must consist solely of the decimal digits 0
+through 9, and must not bear leading zeros (e.g.: "007"). This guarantees that
+the string form of any parsed value is identical to its input. See
+[NewLenientNumberForm] for legacy data.
+
+Any error returned is a *[ParseError].
 */
 func NewNumberForm(v any) (r NumberForm, err error) {
+	return newNumberForm(v, false)
+}
+
+/*
+NewLenientNumberForm returns an instance of [NumberForm] alongside an error
+in the same manner as [NewNumberForm], except that string input may bear
+leading zeros, which are discarded (e.g.: "007" yields 7), for the benefit
+of legacy data.
+*/
+func NewLenientNumberForm(v any) (r NumberForm, err error) {
+	return newNumberForm(v, true)
+}
+
+func newNumberForm(v any, lenient bool) (r NumberForm, err error) {
 	switch tv := v.(type) {
 	case *big.Int:
+		if tv == nil {
+			err = parseErr(ErrEmpty, `<nil>`, -1, -1)
+			break
+		} else if tv.Sign() < 0 {
+			err = parseErr(ErrNegative, tv.String(), -1, 0)
+			break
+		}
 		r = NumberForm(*tv)
 	case string:
-		var _a *big.Int
-		if _a, err = newStringNF(tv); err == nil {
-			r = NumberForm(*_a)
-		}
+		r, err = parseNF(tv, nil, lenient)
 	case int:
 		if tv < 0 {
-			err = errorf("A NumberForm cannot be negative")
+			err = parseErr(ErrNegative, sprintf("%d", tv), -1, 0)
 			break
 		}
-
-		_a := big.NewInt(int64(tv))
-		r = NumberForm(*_a)
+		(*big.Int)(&r).SetUint64(uint64(tv))
 	case uint64:
-		_a := big.NewInt(0).SetUint64(tv)
-		r = NumberForm(*_a)
+		(*big.Int)(&r).SetUint64(tv)
 	case uint:
-		_a := big.NewInt(0).SetUint64(uint64(tv))
-		r = NumberForm(*_a)
+		(*big.Int)(&r).SetUint64(uint64(tv))
 	default:
-		err = errorf("Unsupported %T type '%T'", r, tv)
+		err = parseErr(ErrUnsupportedType, sprintf("%T", tv), -1, -1)
 	}
 
 	return
 }
+
+/*
+cmpUint64 returns -1, 0 or +1 following a comparison of a and b.
+*/
+func cmpUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+/*
+appendVLQ appends the VLQ encoding of v to b, returning the extended
+slice.
+*/
+func appendVLQ(b []byte, v uint64) []byte {
+	var tmp [10]byte
+	i := len(tmp) - 1
+	tmp[i] = byte(v & 0x7F)
+	for v >>= 7; v > 0; v >>= 7 {
+		i--
+		tmp[i] = byte(v&0x7F) | 0x80
+	}
+
+	return append(b, tmp[i:]...)
+}
@@ -245,3 +245,64 @@ func ExampleNumberForm_Lt_byUint64() {
 	fmt.Printf("%s < %d: %t", nf, oth, nf.Lt(oth))
 	// Output: 4658 < 4501: false
 }
+
+func ExampleNewLenientDotNotation() {
+	_, err := NewDotNotation(`1.3.06.1`)
+	fmt.Println(err)
+
+	dot, _ := NewLenientDotNotation(`1.3.06.1`)
+	fmt.Println(dot)
+	// Output:
+	// Non-canonical NumberForm: leading zeros are not permitted: '06' in '1.3.06.1' (arc 2, offset 4)
+	// 1.3.6.1
+}
+
+func TestNumberForm_canonical(t *testing.T) {
+	for _, bogus := range []string{`007`, `00`, `+5`, ` 5`, `5 `, `0x10`, `1_000`} {
+		if _, err := NewNumberForm(bogus); err == nil {
+			t.Errorf("%s failed: non-canonical '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+
+	for _, bogus := range []string{`1.3.06.1`, `01.3`, `1.03`, `1.3.+6`} {
+		if _, err := NewDotNotation(bogus); err == nil {
+			t.Errorf("%s failed: non-canonical '%s' parsed without error", t.Name(), bogus)
+		}
+	}
+
+	if _, err := NewOID(`{iso(1) identified-organization(003)}`); err == nil {
+		t.Errorf("%s failed: non-canonical NameAndNumberForm parsed without error", t.Name())
+	}
+
+	// canonical input must round-trip losslessly
+	for _, valid := range []string{`0.0`, `1.3.6.1.4.1.56521`, `2.25.10`, `2.999.0.100`} {
+		if dot, err := NewDotNotation(valid); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != valid {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), valid, dot)
+		}
+	}
+
+	if nf, err := NewLenientNumberForm(`007`); err != nil || nf.String() != `7` {
+		t.Errorf("%s failed: lenient parse yielded %s (%v)", t.Name(), nf, err)
+	}
+	if _, err := NewLenientNumberForm(`+5`); err == nil {
+		t.Errorf("%s failed: signed number parsed without error in lenient mode", t.Name())
+	}
+	if dot, err := NewLenientDotNotation(`1.3.06.1`); err != nil || dot.String() != `1.3.6.1` {
+		t.Errorf("%s failed: lenient parse yielded %s (%v)", t.Name(), dot, err)
+	}
+	if dot, err := NewLenientDotNotation(`1`, `03`, uint(6)); err != nil || dot.String() != `1.3.6` {
+		t.Errorf("%s failed: lenient parse yielded %s (%v)", t.Name(), dot, err)
+	}
+	if oid, err := NewLenientOID(`{iso(01) identified-organization(003) 06}`); err != nil ||
+		oid.String() != `{iso(1) identified-organization(3) 6}` {
+		t.Errorf("%s failed: lenient parse yielded %s (%v)", t.Name(), oid, err)
+	}
+
+	// lenient parsing must not leak into
+	// subsequent strict calls.
+	if _, err := NewNumberForm(`007`); err == nil {
+		t.Errorf("%s failed: strict parse accepted leading zeros", t.Name())
+	}
+}
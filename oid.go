@@ -19,6 +19,17 @@ func (r *OID) IsZero() (is bool) {
 	return
 }
 
+/*
+String is a stringer method that returns the ASN.1 string representation
+of the receiver (e.g.: "{iso(1) identified-organization(3)}").
+*/
+func (r OID) String() (s string) {
+	if !r.IsZero() {
+		s = r.nanf.String()
+	}
+	return
+}
+
 /*
 Dot returns a [DotNotation] instance based on the contents of the underlying [ASN1Notation]
 instance found within the receiver.
@@ -63,7 +74,7 @@ func (r OID) Valid() (ok bool) {
 					break
 				}
 			}
-			ok = found
+			ok = found && r.nanf.misplacedLongArc() == -1
 		}
 	}
 	return
@@ -136,44 +147,60 @@ Case is significant during processing of the above abbreviations.  Note that it
 inappropriate to utilize these abbreviations for any portion of an [OID] instance
 other than as the respective root node.
 
-[NumberForm] values CANNOT be negative, but are unbounded in their magnitude.
+Optionally, a [Resolver] -- such as a *[Registry] -- may be supplied, allowing other
+arcs to bear a name but no number. For example:
+
+	{iso identified-organization(3) dod 1}
+
+See [NewASN1Notation] for details.
+
+[NumberForm] values CANNOT be negative, but are unbounded in their magnitude, and
+must not bear leading zeros; see [NewLenientOID] for legacy data.
+
+Any error returned is a *[ParseError]; see [NewASN1Notation].
 */
-func NewOID(x any) (r *OID, err error) {
+func NewOID(x any, resolver ...Resolver) (r *OID, err error) {
+	return newOID(x, resolver, false)
+}
+
+/*
+NewLenientOID returns an instance of *[OID] alongside an error in the same
+manner as [NewOID], except that number forms may bear leading zeros, which
+are discarded (e.g.: "{iso(01) identified-organization(003)}" yields
+"{iso(1) identified-organization(3)}"), for the benefit of legacy data.
+*/
+func NewLenientOID(x any, resolver ...Resolver) (r *OID, err error) {
+	return newOID(x, resolver, true)
+}
+
+func newOID(x any, resolver []Resolver, lenient bool) (r *OID, err error) {
 	// prepare temporary instance
 	t := new(OID)
 	r = new(OID)
 
+	var input string
 	var nfs []string
 	switch tv := x.(type) {
 	case []NameAndNumberForm:
 		t.nanf = ASN1Notation(tv)
-		if !t.Valid() {
-			err = errorf("%T instance did not pass validity checks: %#v", t, t)
-			break
+		if err = t.nanf.validate(t.nanf.String(), nil); err == nil {
+			r.nanf = t.nanf
+			r.parsed = true
 		}
-		r.nanf = t.nanf
-		r.parsed = true
 		return
 	case string:
+		input = tv
 		nfs = fields(condenseWHSP(trimR(trimL(tv, `{`), `}`)))
 	case []string:
+		input = join(tv, ` `)
 		nfs = tv
 	default:
-		err = errorf("Unsupported %T input type: %#v\n", x, x)
+		err = parseErr(ErrUnsupportedType, sprintf("%T", x), -1, -1)
 		return
 	}
 
-	for i := 0; i < len(nfs); i++ {
-		var nanf *NameAndNumberForm
-		if nanf, err = NewNameAndNumberForm(nfs[i]); err != nil {
-			break
-		}
-		t.nanf = append(t.nanf, *nanf)
-	}
-
-	if err == nil {
-		if !t.Valid() {
-			err = errorf("%T instance did not pass validity checks: %#v", t, *t)
+	if t.nanf, err = parseArcs(input, nfs, resolver, lenient); err == nil {
+		if err = t.nanf.validate(input, arcOffsets(input, nfs)); err != nil {
 			return
 		}
 
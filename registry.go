@@ -0,0 +1,561 @@
+package objectid
+
+/*
+registry.go implements a hierarchical registry of known OID arcs.
+*/
+
+import (
+	"slices"
+	"sync"
+)
+
+/*
+Registry is a hierarchical store of known OID arcs, each of which is
+represented by a [NameAndNumberForm] and an optional description.
+
+Instances of this type are safe for concurrent use, and should only be
+initialized using the [NewRegistry] function. A *[Registry] qualifies
+as a [LabelSource].
+*/
+type Registry struct {
+	mu    sync.RWMutex
+	roots []*registryNode
+	names map[string][]*registryNode
+	count int
+}
+
+/*
+registryNode is a single arc within a [Registry].
+*/
+type registryNode struct {
+	nanf        NameAndNumberForm
+	description string
+	parent      *registryNode
+	children    []*registryNode // ordered by NumberForm
+}
+
+/*
+Entry is a single arc returned by the lookup methods of [Registry].
+*/
+type Entry struct {
+	oid         OID
+	description string
+}
+
+/*
+OID returns the fully-qualified [OID] of the receiver instance, bearing
+all identifiers known to the [Registry] at the time of lookup.
+*/
+func (r Entry) OID() OID {
+	return r.oid
+}
+
+/*
+Dot returns the [DotNotation] of the receiver instance.
+*/
+func (r Entry) Dot() DotNotation {
+	return r.oid.Dot()
+}
+
+/*
+NameAndNumberForm returns the leaf-node [NameAndNumberForm] of the
+receiver instance.
+*/
+func (r Entry) NameAndNumberForm() NameAndNumberForm {
+	return r.oid.Leaf()
+}
+
+/*
+Description returns the description of the receiver instance, if set.
+*/
+func (r Entry) Description() string {
+	return r.description
+}
+
+/*
+String is a stringer method that returns the ASN.1 string representation
+of the receiver instance.
+*/
+func (r Entry) String() string {
+	return r.oid.String()
+}
+
+/*
+NewRegistry returns a freshly initialized instance of *[Registry], bearing
+only the root arcs itu-t(0), iso(1) and joint-iso-itu-t(2).
+*/
+func NewRegistry() (r *Registry) {
+	r = &Registry{names: make(map[string][]*registryNode)}
+	for i, name := range []string{`itu-t`, `iso`, `joint-iso-itu-t`} {
+		nf, _ := NewNumberForm(i)
+		r.newNode(nil, NameAndNumberForm{
+			identifier:        name,
+			primaryIdentifier: nf,
+			parsed:            true,
+		})
+	}
+
+	return
+}
+
+/*
+Len returns the integer number of arcs present within the receiver.
+*/
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.count
+}
+
+/*
+Register returns an error following an attempt to register the input value,
+which may be any value accepted by [NewOID], as well as an instance of [OID],
+*[OID], [ASN1Notation], *[ASN1Notation], [DotNotation] or *[DotNotation].
+
+Name-only arcs (e.g.: "dod") are resolved through the receiver, exactly as
+with [Registry.ResolveASN1]. Any ancestral arcs not yet known are registered
+implicitly. The input description, if non-zero, is assigned to the leaf arc.
+
+An error, wrapping [ErrIdentifierConflict] within a *[ParseError], is
+returned if an arc bears an identifier which conflicts with that of an arc
+already registered. An error wrapping [ErrDuplicateIdentifier] is returned
+if any of its identifiers, primary or secondary, is already registered to
+a sibling arc.
+*/
+func (r *Registry) Register(x any, description string) (err error) {
+	var A *ASN1Notation
+	if A, err = r.ResolveASN1(x); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// verify everything before modifying anything.
+	var parent *registryNode
+	for i := 0; i < A.Len() && err == nil; i++ {
+		nanf := (*A)[i]
+		node := r.child(parent, nanf.primaryIdentifier)
+		if node != nil && len(node.nanf.identifier) > 0 && len(nanf.identifier) > 0 &&
+			node.nanf.identifier != nanf.identifier {
+			err = parseErr(ErrIdentifierConflict, nanf.identifier, i, -1)
+			break
+		}
+
+		ids := append([]string{nanf.identifier}, nanf.secondaryIdentifiers...)
+		for j := 0; j < len(ids) && err == nil; j++ {
+			if sib := r.childByName(parent, ids[j]); sib != nil && sib != node {
+				err = parseErr(ErrDuplicateIdentifier, ids[j], i, -1)
+			}
+		}
+		parent = node
+		if node == nil {
+			break
+		}
+	}
+
+	if err != nil {
+		return
+	}
+
+	parent = nil
+	for i := 0; i < A.Len(); i++ {
+		nanf := (*A)[i]
+		node := r.child(parent, nanf.primaryIdentifier)
+		if node == nil {
+			node = r.newNode(parent, nanf)
+		} else {
+			node.merge(nanf)
+			r.index(node)
+		}
+		parent = node
+	}
+
+	if len(description) > 0 {
+		parent.description = description
+	}
+
+	return
+}
+
+/*
+LookupDot returns an instance of *[Entry] alongside a Boolean value indicative
+of success following an attempt to locate the arc identified by the input value,
+which may be a string, [DotNotation] or *[DotNotation].
+*/
+func (r *Registry) LookupDot(dot any) (e *Entry, ok bool) {
+	D := assertDotNot(dot)
+	if D == nil || D.IsZero() {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var node *registryNode
+	for i := 0; i < D.Len(); i++ {
+		if node = r.child(node, (*D)[i]); node == nil {
+			return
+		}
+	}
+
+	e, ok = node.entry(), true
+
+	return
+}
+
+/*
+LookupASN1 returns an instance of *[Entry] alongside a Boolean value indicative
+of success following an attempt to locate the arc identified by the input ASN.1
+name path. Any input accepted by [Registry.ResolveASN1] is permitted, such as
+"{iso identified-organization dod internet}".
+*/
+func (r *Registry) LookupASN1(x any) (e *Entry, ok bool) {
+	if A, err := r.ResolveASN1(x); err == nil {
+		e, ok = r.LookupDot(A.numberForms())
+	}
+
+	return
+}
+
+/*
+LookupIdentifier returns slices of *[Entry] instances, each of which bears
+the input identifier -- whether primary or secondary -- as its leaf arc. As
+identifiers need only be unique amongst siblings, multiple entries may be
+returned. Entries are ordered per the [CompareOID] function.
+*/
+func (r *Registry) LookupIdentifier(id string) (entries []*Entry) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, node := range r.names[id] {
+		entries = append(entries, node.entry())
+	}
+
+	slices.SortFunc(entries, func(a, b *Entry) int {
+		return CompareOID(a.oid, b.oid)
+	})
+
+	return
+}
+
+/*
+ResolveASN1 returns an instance of *[ASN1Notation] alongside an error
+following an attempt to resolve the input value through the receiver.
+Valid input types are those accepted by [Registry.Register].
+
+Each arc of string input may be expressed in nameAndNumber form (e.g.:
+"dod(6)"), number form (e.g.: "6") or name form (e.g.: "dod"). Name-only
+arcs are resolved through the receiver, while arcs lacking an identifier
+are assigned the identifier known to the receiver, if any. For example,
+"{iso identified-organization dod 1}" may resolve to:
+
+	{iso(1) identified-organization(3) dod(6) internet(1)}
+
+Any error returned is a *[ParseError], identifying the offending arc; one
+wrapping [ErrUnresolvedArc] is returned if any name-only arc cannot be
+resolved.
+*/
+func (r *Registry) ResolveASN1(x any) (A *ASN1Notation, err error) {
+	var arcs []string
+	switch tv := x.(type) {
+	case string:
+		arcs = fields(condenseWHSP(trimR(trimL(trimS(tv), `{`), `}`)))
+	case []string:
+		arcs = tv
+	default:
+		var asn ASN1Notation
+		if asn, err = assertRegistrable(x); err == nil {
+			A, err = r.resolveNameAndNumberForms(asn)
+		}
+		return
+	}
+
+	asn := make(ASN1Notation, len(arcs))
+	for i := 0; i < len(arcs) && err == nil; i++ {
+		if isIdentifier(arcs[i]) {
+			asn[i] = NameAndNumberForm{identifier: arcs[i]}
+			continue
+		}
+
+		var nanf *NameAndNumberForm
+		if nanf, err = NewNameAndNumberForm(arcs[i]); err == nil {
+			asn[i] = *nanf
+		} else {
+			err = reparse(err, ``, i, -1)
+		}
+	}
+
+	if err == nil {
+		A, err = r.resolveNameAndNumberForms(asn)
+	}
+
+	return
+}
+
+/*
+resolveNameAndNumberForms resolves the input [ASN1Notation] instance, which
+may contain unparsed name-only arcs, through the receiver.
+*/
+func (r *Registry) resolveNameAndNumberForms(asn ASN1Notation) (A *ASN1Notation, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var node *registryNode
+	_A := make(ASN1Notation, asn.Len())
+	for i := 0; i < asn.Len(); i++ {
+		nanf := asn[i]
+		if !nanf.parsed {
+			if node = r.childByName(node, nanf.identifier); node == nil {
+				err = parseErr(ErrUnresolvedArc, nanf.identifier, i, -1)
+				return
+			}
+			nanf.primaryIdentifier = node.nanf.primaryIdentifier
+			nanf.parsed = true
+		} else if node = r.child(node, nanf.primaryIdentifier); node != nil && len(nanf.identifier) == 0 {
+			nanf.identifier = node.nanf.identifier
+		}
+
+		_A[i] = nanf
+		if node == nil {
+			// nothing further can be resolved
+			// beneath an unregistered arc.
+			for j := i + 1; j < asn.Len() && err == nil; j++ {
+				if !asn[j].parsed {
+					err = parseErr(ErrUnresolvedArc, asn[j].identifier, j, -1)
+				}
+				_A[j] = asn[j]
+			}
+			break
+		}
+	}
+
+	if err == nil {
+		if err = _A.validate(_A.String(), nil); err == nil {
+			A = &_A
+		}
+	}
+
+	return
+}
+
+/*
+Label returns the preferred non-integer Unicode label of the arc identified
+by the input [DotNotation], alongside a Boolean value indicative of success.
+This, alongside the [Registry.Resolve] method, satisfies the [LabelSource]
+interface.
+*/
+func (r *Registry) Label(dot DotNotation) (label string, ok bool) {
+	if e, found := r.LookupDot(dot); found {
+		label, ok = e.NameAndNumberForm().nonIntegerLabel()
+	}
+
+	return
+}
+
+/*
+Resolve returns the [DotNotation] of the arc bearing the input Unicode label
+beneath the input parent [DotNotation], alongside a Boolean value indicative
+of success. A zero parent resolves long arcs as well as the root arcs. This,
+alongside the [Registry.Label] method, satisfies the [LabelSource] interface.
+*/
+func (r *Registry) Resolve(parent DotNotation, label string) (dot DotNotation, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var node *registryNode
+	if parent.IsZero() {
+		node, ok = r.longArc(r.roots, label)
+	} else {
+		for i := 0; i < parent.Len(); i++ {
+			if node = r.child(node, parent[i]); node == nil {
+				return
+			}
+		}
+
+		for _, c := range node.children {
+			if ok = strInSlice(label, c.nanf.unicodeLabels); ok {
+				node = c
+				break
+			}
+		}
+	}
+
+	if ok {
+		dot = node.entry().Dot()
+	}
+
+	return
+}
+
+/*
+longArc performs a depth-first search for a long arc bearing label.
+*/
+func (r *Registry) longArc(nodes []*registryNode, label string) (node *registryNode, ok bool) {
+	for i := 0; i < len(nodes) && !ok; i++ {
+		if nodes[i].nanf.longArc && strInSlice(label, nodes[i].nanf.unicodeLabels) {
+			node, ok = nodes[i], true
+		} else {
+			node, ok = r.longArc(nodes[i].children, label)
+		}
+	}
+
+	return
+}
+
+/*
+child returns the child of parent bearing number form nf, or nil if not
+found. A nil parent indicates the root. The caller must hold a lock.
+*/
+func (r *Registry) child(parent *registryNode, nf NumberForm) *registryNode {
+	nodes := r.roots
+	if parent != nil {
+		nodes = parent.children
+	}
+
+	if i, found := slices.BinarySearchFunc(nodes, nf, func(n *registryNode, nf NumberForm) int {
+		return n.nanf.primaryIdentifier.cmp(nf)
+	}); found {
+		return nodes[i]
+	}
+
+	return nil
+}
+
+/*
+childByName returns the child of parent bearing the input identifier as
+its primary or secondary identifier, or nil if not found. A nil parent
+indicates the root. The caller must hold a lock.
+*/
+func (r *Registry) childByName(parent *registryNode, id string) *registryNode {
+	if len(id) == 0 {
+		return nil
+	}
+
+	nodes := r.roots
+	if parent != nil {
+		nodes = parent.children
+	}
+
+	for _, node := range nodes {
+		if node.nanf.identifier == id || strInSlice(id, node.nanf.secondaryIdentifiers) {
+			return node
+		}
+	}
+
+	return nil
+}
+
+/*
+newNode creates and inserts a new child of parent. A nil parent indicates
+the root. The caller must hold a lock.
+*/
+func (r *Registry) newNode(parent *registryNode, nanf NameAndNumberForm) (node *registryNode) {
+	node = &registryNode{nanf: nanf, parent: parent}
+
+	nodes := &r.roots
+	if parent != nil {
+		nodes = &parent.children
+	}
+
+	i, _ := slices.BinarySearchFunc(*nodes, nanf.primaryIdentifier, func(n *registryNode, nf NumberForm) int {
+		return n.nanf.primaryIdentifier.cmp(nf)
+	})
+	*nodes = slices.Insert(*nodes, i, node)
+
+	r.count++
+	r.index(node)
+
+	return
+}
+
+/*
+index adds the identifiers of node to the receiver's identifier index.
+The caller must hold a lock.
+*/
+func (r *Registry) index(node *registryNode) {
+	ids := append([]string{node.nanf.identifier}, node.nanf.secondaryIdentifiers...)
+	for _, id := range ids {
+		if len(id) > 0 && !slices.Contains(r.names[id], node) {
+			r.names[id] = append(r.names[id], node)
+		}
+	}
+}
+
+/*
+merge adopts any identifiers, Unicode labels and long arc designation
+present within nanf which are absent from the receiver.
+*/
+func (r *registryNode) merge(nanf NameAndNumberForm) {
+	if len(r.nanf.identifier) == 0 {
+		r.nanf.identifier = nanf.identifier
+	}
+
+	for _, id := range nanf.secondaryIdentifiers {
+		if id != r.nanf.identifier && !strInSlice(id, r.nanf.secondaryIdentifiers) {
+			r.nanf.secondaryIdentifiers = append(r.nanf.secondaryIdentifiers, id)
+		}
+	}
+
+	for _, label := range nanf.unicodeLabels {
+		if !strInSlice(label, r.nanf.unicodeLabels) {
+			r.nanf.unicodeLabels = append(r.nanf.unicodeLabels, label)
+		}
+	}
+
+	r.nanf.longArc = r.nanf.longArc || nanf.longArc
+}
+
+/*
+entry returns an instance of *[Entry] based upon the receiver. The caller
+must hold a lock.
+*/
+func (r *registryNode) entry() *Entry {
+	var asn ASN1Notation
+	for node := r; node != nil; node = node.parent {
+		asn = append(ASN1Notation{node.nanf}, asn...)
+	}
+
+	return &Entry{
+		oid:         OID{nanf: asn, parsed: true},
+		description: r.description,
+	}
+}
+
+/*
+assertRegistrable returns an [ASN1Notation] based upon the input value
+alongside an error.
+*/
+func assertRegistrable(x any) (asn ASN1Notation, err error) {
+	switch tv := x.(type) {
+	case OID:
+		asn = tv.nanf
+	case *OID:
+		if tv != nil {
+			asn = tv.nanf
+		}
+	case ASN1Notation:
+		asn = tv
+	case *ASN1Notation:
+		if tv != nil {
+			asn = *tv
+		}
+	case []NameAndNumberForm:
+		asn = ASN1Notation(tv)
+	case DotNotation, *DotNotation:
+		D := assertDotNot(tv)
+		for i := 0; D != nil && i < D.Len(); i++ {
+			asn = append(asn, NameAndNumberForm{primaryIdentifier: (*D)[i], parsed: true})
+		}
+	default:
+		err = parseErr(ErrUnsupportedType, sprintf("%T", x), -1, -1)
+		return
+	}
+
+	if asn.Len() == 0 {
+		err = parseErr(ErrEmpty, sprintf("%T", x), -1, -1)
+	}
+
+	return
+}
@@ -0,0 +1,152 @@
+package objectid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleRegistry_ResolveASN1() {
+	reg := NewRegistry()
+	_ = reg.Register(`{iso(1) identified-organization(3) dod(6) internet(1)}`, `Internet`)
+
+	asn, err := reg.ResolveASN1(`{iso identified-organization dod 1 private(4)}`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", asn)
+	// Output: {iso(1) identified-organization(3) dod(6) internet(1) private(4)}
+}
+
+func ExampleRegistry_LookupDot() {
+	reg := NewRegistry()
+	_ = reg.Register(`{iso(1) identified-organization(3) dod(6) internet(1)}`, `Internet`)
+
+	e, ok := reg.LookupDot(`1.3.6.1`)
+	if !ok {
+		fmt.Println("not found")
+		return
+	}
+
+	fmt.Printf("%s: %s", e, e.Description())
+	// Output: {iso(1) identified-organization(3) dod(6) internet(1)}: Internet
+}
+
+func TestRegistry(t *testing.T) {
+	reg := NewRegistry()
+	if reg.Len() != 3 || len(reg.roots) != 3 {
+		t.Errorf("%s failed: want 3 root arcs, got %d", t.Name(), reg.Len())
+		return
+	}
+
+	for _, x := range []any{
+		`{iso(1) identified-organization(3) dod(6) internet(1)}`,
+		`{iso identified-organization dod internet private(4) enterprise(1)}`,
+		[]string{`iso`, `member-body(2)`, `us(840)`},
+		`{joint-iso-itu-t(2) example(999)}`,
+		assertDotNot(`1.3.6.1.4.1.56521`),
+	} {
+		if err := reg.Register(x, ``); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		}
+	}
+
+	// adopt a name for a previously unnamed arc, and a description
+	if err := reg.Register(`{1 3 6 1 4 1 example(56521)}`, `Example`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if e, ok := reg.LookupASN1(`{iso identified-organization dod internet private enterprise example}`); !ok {
+		t.Errorf("%s failed: entry not found", t.Name())
+	} else if e.Dot().String() != `1.3.6.1.4.1.56521` || e.Description() != `Example` ||
+		e.NameAndNumberForm().Identifier() != `example` {
+		t.Errorf("%s failed: unexpected entry %s (%s)", t.Name(), e, e.Description())
+	} else if e.OID().Len() != 7 {
+		t.Errorf("%s failed: unexpected length %d", t.Name(), e.OID().Len())
+	}
+
+	if entries := reg.LookupIdentifier(`example`); len(entries) != 2 {
+		t.Errorf("%s failed: want 2 entries, got %d", t.Name(), len(entries))
+	} else if entries[0].Dot().String() != `1.3.6.1.4.1.56521` {
+		t.Errorf("%s failed: unexpected order %v", t.Name(), entries)
+	}
+
+	// secondary identifier already borne by sibling dod(6)
+	asn, _ := NewASN1Notation(`{iso(1) identified-organization(3) nato(57)}`)
+	_ = (*asn)[2].SetSecondaryIdentifiers(`dod`)
+
+	for idx, bogus := range []struct {
+		x    any
+		kind error
+	}{
+		{`{iso(1) identified-organization(3) ieee(6)}`, ErrIdentifierConflict},
+		{`{iso(1) identified-organization(3) dod(7)}`, ErrDuplicateIdentifier},
+		{asn, ErrDuplicateIdentifier},
+		{`{iso identified-organization bogus}`, ErrUnresolvedArc},
+		{`{iso 17 unknown}`, ErrUnresolvedArc},
+		{`{bogus(1)}`, ErrIdentifierConflict},
+		{`{iso x_y(5)}`, ErrInvalidIdentifier},
+		{`{tree(3) x(1)}`, ErrInvalidRoot},
+		{float32(1), ErrUnsupportedType},
+		{DotNotation{}, ErrEmpty},
+	} {
+		var pe *ParseError
+		if err := reg.Register(bogus.x, ``); !errors.Is(err, bogus.kind) {
+			t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, bogus.kind, err)
+		} else if !errors.As(err, &pe) {
+			t.Errorf("%s[%d] failed: %T is not a %T", t.Name(), idx, err, pe)
+		}
+	}
+
+	if e, ok := reg.LookupDot(`1.3.57`); ok {
+		t.Errorf("%s failed: conflicting arc %s registered", t.Name(), e)
+	}
+
+	if _, ok := reg.LookupDot(`1.3.6.1.5`); ok {
+		t.Errorf("%s failed: unregistered arc found", t.Name())
+	} else if _, ok = reg.LookupDot(``); ok {
+		t.Errorf("%s failed: zero arc found", t.Name())
+	}
+}
+
+func TestRegistry_LabelSource(t *testing.T) {
+	reg := NewRegistry()
+
+	root, _ := NewNameAndNumberForm(`joint-iso-itu-t(2)`)
+	example, _ := NewNameAndNumberForm(`example(999)`)
+	_ = example.SetUnicodeLabels(`Example`)
+	_ = example.SetLongArc(true)
+	child, _ := NewNameAndNumberForm(1)
+	_ = child.SetUnicodeLabels(`Ünïcödé`)
+
+	if err := reg.Register([]NameAndNumberForm{*root, *example, *child}, ``); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var src LabelSource = reg
+	for _, raw := range []string{`/Example/Ünïcödé`, `/Joint-ISO-ITU-T/Example/Ünïcödé`} {
+		iri, _ := NewIRINotation(raw)
+		if dot, err := iri.Dot(src); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if dot.String() != `2.999.1` {
+			t.Errorf("%s failed: unexpected %T '%s'", t.Name(), dot, dot)
+		}
+	}
+
+	dot, _ := NewDotNotation(`2.999.1`)
+	if got := dot.IRI(src).String(); got != `/Joint-ISO-ITU-T/Example/Ünïcödé` {
+		t.Errorf("%s failed: unexpected OID-IRI '%s'", t.Name(), got)
+	}
+
+	if _, ok := reg.Resolve(DotNotation(*dot), `Bogus`); ok {
+		t.Errorf("%s failed: bogus label resolved", t.Name())
+	}
+	if _, ok := reg.Resolve(*assertDotNot(`1.3.6`), `Bogus`); ok {
+		t.Errorf("%s failed: bogus label resolved", t.Name())
+	}
+}
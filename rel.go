@@ -0,0 +1,275 @@
+package objectid
+
+/*
+rel.go handles RELATIVE-OID operations.
+*/
+
+import "math/big"
+
+/*
+RelativeOID contains an ordered sequence of [NumberForm] instances which
+identify an object relative to some (implied) base OID, per ITU-T Rec.
+X.680 clause 33. Unlike [DotNotation], no constraints are imposed upon
+the first or second arcs, and a single arc is permitted.
+*/
+type RelativeOID []NumberForm
+
+/*
+String is a stringer method that returns the dot notation form of the
+receiver (e.g.: "4.1.56521").
+*/
+func (r RelativeOID) String() string {
+	return DotNotation(r).String()
+}
+
+/*
+Len returns the integer length of the receiver.
+*/
+func (r RelativeOID) Len() int {
+	return len(r)
+}
+
+/*
+IsZero returns a Boolean indicative of whether the receiver is unset.
+*/
+func (r *RelativeOID) IsZero() (is bool) {
+	if r != nil {
+		is = r.Len() == 0
+	}
+	return
+}
+
+/*
+Valid returns a Boolean value indicative of whether the receiver's
+length is greater than or equal to one (1) slice member.
+*/
+func (r RelativeOID) Valid() bool {
+	return r.Len() > 0
+}
+
+/*
+Index returns the Nth index from the receiver, alongside a Boolean
+value indicative of success. This method supports the use of negative
+indices.
+*/
+func (r RelativeOID) Index(idx int) (NumberForm, bool) {
+	return DotNotation(r).Index(idx)
+}
+
+/*
+NewRelativeOID returns an instance of *[RelativeOID] alongside an error.
+
+Variadic input allows for slice mixtures of all of the following types,
+each treated as an individual [NumberForm] instance:
+
+  - *[math/big.Int]
+  - [NumberForm]
+  - string
+  - uint64
+  - uint
+  - int
+
+If a string primitive is the only input option, it will be treated as a
+complete [RelativeOID] (e.g.: "4.1.56521").
+
+Any error returned is a *[ParseError], in the same manner as [NewDotNotation].
+*/
+func NewRelativeOID(x ...any) (r *RelativeOID, err error) {
+	_r := make(RelativeOID, 0)
+
+	if len(x) == 1 {
+		if slice, ok := x[0].(string); ok {
+			r, err = newRelativeOIDStr(slice)
+			return
+		}
+	}
+
+	for i := 0; i < len(x) && err == nil; i++ {
+		var nf NumberForm
+		switch tv := x[i].(type) {
+		case NumberForm:
+			if !tv.Valid() {
+				err = parseErr(ErrEmpty, tv.String(), i, -1)
+				break
+			}
+			nf = tv
+		case *big.Int, string, uint64, uint, int:
+			if nf, err = NewNumberForm(tv); err != nil {
+				err = reparse(err, ``, i, -1)
+			}
+		default:
+			err = parseErr(ErrUnsupportedType, sprintf("%T", tv), i, -1)
+		}
+
+		_r = append(_r, nf)
+	}
+
+	if err == nil {
+		if !_r.Valid() {
+			err = parseErr(ErrEmpty, ``, -1, -1)
+			return
+		}
+		r = new(RelativeOID)
+		*r = _r
+	}
+
+	return
+}
+
+func newRelativeOIDStr(rel string) (r *RelativeOID, err error) {
+	if len(rel) == 0 {
+		err = parseErr(ErrEmpty, rel, -1, -1)
+		return
+	}
+
+	z := split(rel, `.`)
+	_r := make(RelativeOID, 0, len(z))
+	for j, off := 0, 0; j < len(z); j++ {
+		var nf NumberForm
+		if nf, err = NewNumberForm(z[j]); err != nil {
+			err = reparse(err, rel, j, off)
+			return
+		}
+		_r = append(_r, nf)
+		off += len(z[j]) + 1
+	}
+
+	r = &_r
+
+	return
+}
+
+/*
+Encode returns the ASN.1 encoding of the receiver instance, bearing the
+RELATIVE-OID tag (0x0D), alongside an error.
+*/
+func (r RelativeOID) Encode() (b []byte, err error) {
+	var content []byte
+	if content, err = r.encodeContent(); err == nil {
+		b = encodeTLV(content, 0x0D) // ASN.1 RELATIVE-OID Tag (0x0D)
+	}
+
+	return
+}
+
+/*
+EncodeContent returns the ASN.1 content octets of the receiver instance,
+absent the identifier (tag) and length octets, alongside an error.
+*/
+func (r RelativeOID) EncodeContent() ([]byte, error) {
+	return r.encodeContent()
+}
+
+/*
+EncodeImplicit returns the ASN.1 encoding of the receiver instance, bearing
+the context-specific tag number n in place of the RELATIVE-OID tag, alongside
+an error.
+*/
+func (r RelativeOID) EncodeImplicit(n uint) (b []byte, err error) {
+	var content []byte
+	if content, err = r.encodeContent(); err == nil {
+		b = encodeTLV(content, contextTag(n)...)
+	}
+
+	return
+}
+
+func (r RelativeOID) encodeContent() (b []byte, err error) {
+	if !r.Valid() {
+		err = errorf("Length below encoding minimum")
+		return
+	}
+
+	for i := 0; i < len(r); i++ {
+		b = r[i].appendVLQ(b)
+	}
+
+	return
+}
+
+/*
+Decode returns an error following an attempt to parse b, which must be
+the ASN.1 encoding of a RELATIVE-OID, into the receiver instance. The
+receiver instance is reinitialized upon success.
+
+Optional [DecodeOption] values may be supplied to enforce stricter rules,
+exactly as with [DotNotation.Decode].
+*/
+func (r *RelativeOID) Decode(b []byte, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts), 0x0D)
+}
+
+/*
+DecodeContent returns an error following an attempt to parse b, which must
+be the content octets of an ASN.1 encoded RELATIVE-OID -- absent any tag
+and length octets -- into the receiver instance. See [DotNotation.DecodeContent]
+for details.
+*/
+func (r *RelativeOID) DecodeContent(b []byte, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts))
+}
+
+/*
+DecodeImplicit returns an error following an attempt to parse b, which must
+be the ASN.1 encoding of a RELATIVE-OID bearing the context-specific tag number
+n in place of the RELATIVE-OID tag, into the receiver instance. See
+[DotNotation.DecodeImplicit] for details.
+*/
+func (r *RelativeOID) DecodeImplicit(b []byte, n uint, opts ...DecodeOption) error {
+	return r.decode(b, decodeOptions(opts), contextTag(n)...)
+}
+
+func (r *RelativeOID) decode(b []byte, o DecodeOption, id ...byte) (err error) {
+	var content []byte
+	var hdr int
+	if content, hdr, err = decodeHeader(b, o, id...); err == nil {
+		var d DotNotation
+		if d, err = decodeSubidentifiers(content, hdr, o&MinimalSubidentifiers != 0, false); err == nil {
+			*r = RelativeOID(d)
+		}
+	}
+
+	err = decodeInput(err, b)
+
+	return
+}
+
+/*
+Relative returns an instance of *[RelativeOID] alongside an error. The
+return value contains the arcs of the input descendant value -- which
+may be a string, [DotNotation] or *[DotNotation] -- which follow those
+of the receiver instance.
+
+An error, wrapping [ErrNotDescendant] within a *[ParseError], is returned
+if the input value cannot be read, or if the receiver is not an ancestor of
+the input value.
+*/
+func (r DotNotation) Relative(descendant any) (rel *RelativeOID, err error) {
+	D := assertDotNot(descendant)
+	if D == nil || D.IsZero() || !r.AncestorOf(D) {
+		err = parseErr(ErrNotDescendant, sprintf("%v", descendant), -1, -1)
+		return
+	}
+
+	_r := make(RelativeOID, D.Len()-r.Len())
+	copy(_r, (*D)[r.Len():])
+	rel = &_r
+
+	return
+}
+
+/*
+Append returns a new instance of *[DotNotation] comprised of the arcs
+of the receiver instance, followed by those of the input [RelativeOID]
+instance. A nil instance is returned if either value is zero.
+*/
+func (r DotNotation) Append(rel RelativeOID) (dot *DotNotation) {
+	if !r.IsZero() && rel.Valid() {
+		D := make(DotNotation, r.Len()+rel.Len())
+		copy(D, r)
+		copy(D[r.Len():], rel)
+		dot = &D
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+package objectid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewRelativeOID() {
+	rel, err := NewRelativeOID(`4.1.56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", rel)
+	// Output: 4.1.56521
+}
+
+func ExampleRelativeOID_Encode() {
+	rel, _ := NewRelativeOID(`4.1.56521.999`)
+	b, err := rel.Encode()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%v", b)
+	// Output: [13 7 4 1 131 185 73 135 103]
+}
+
+func ExampleDotNotation_Relative() {
+	base, _ := NewDotNotation(`1.3.6.1`)
+	rel, err := base.Relative(`1.3.6.1.4.1.56521`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", rel)
+	// Output: 4.1.56521
+}
+
+func ExampleDotNotation_Append() {
+	base, _ := NewDotNotation(`1.3.6.1`)
+	rel, _ := NewRelativeOID(`4.1.56521`)
+
+	fmt.Printf("%s", base.Append(*rel))
+	// Output: 1.3.6.1.4.1.56521
+}
+
+func TestRelativeOID_Codec(t *testing.T) {
+	for key, want := range map[string][]byte{
+		`0`:                  {0x0d, 0x01, 0x00},
+		`999`:                {0x0d, 0x02, 0x87, 0x67},
+		`4.1.56521.999.0`:    {0x0d, 0x08, 0x04, 0x01, 0x83, 0xb9, 0x49, 0x87, 0x67, 0x00},
+		`85.987895962269883`: {0x0d, 0x09, 0x55, 0x81, 0xe0, 0xcf, 0xc7, 0x9f, 0x9f, 0xf1, 0x3b},
+	} {
+		rel, err := NewRelativeOID(key)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		}
+
+		var b []byte
+		if b, err = rel.Encode(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		} else if !bytes.Equal(b, want) {
+			t.Errorf("%s failed: %s want %#v, got %#v", t.Name(), key, want, b)
+			continue
+		}
+
+		var r2 RelativeOID
+		if err = r2.Decode(b, Strict); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if r2.String() != key {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), key, r2)
+		}
+	}
+
+	var r RelativeOID
+	if err := r.Decode([]byte{0x06, 0x01, 0x2b}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidTag, err)
+	}
+	if err := r.Decode([]byte{0x0d, 0x02, 0x80, 0x01}, Strict); !errors.Is(err, ErrNonMinimalSubidentifier) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrNonMinimalSubidentifier, err)
+	}
+	if _, err := r.Encode(); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+}
+
+func TestRelativeOID_codecov(t *testing.T) {
+	for _, bogus := range []any{``, `1..2`, `1.-2`, `a.b`, float64(1)} {
+		if _, err := NewRelativeOID(bogus); err == nil {
+			t.Errorf("%s failed: bogus %T (%v) parsed without error", t.Name(), bogus, bogus)
+		}
+	}
+
+	nf, _ := NewNumberForm(4)
+	rel, err := NewRelativeOID(nf, 1, uint(56521))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if arc, ok := rel.Index(-1); !ok || !arc.Equal(56521) {
+		t.Errorf("%s failed: unexpected leaf arc %s", t.Name(), arc)
+	}
+
+	base, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+	if _, err = base.Relative(`1.3.6.1`); err == nil {
+		t.Errorf("%s failed: expected error, got nothing", t.Name())
+	}
+
+	// input which cannot be read must not panic
+	var nilDot *DotNotation
+	for _, bogus := range []any{`bogus`, nilDot, 3.14} {
+		if _, err = base.Relative(bogus); !errors.Is(err, ErrNotDescendant) {
+			t.Errorf("%s failed: want %v for %T, got %v", t.Name(), ErrNotDescendant, bogus, err)
+		}
+	}
+
+	var zero DotNotation
+	if zero.Append(*rel) != nil {
+		t.Errorf("%s failed: expected nil %T", t.Name(), zero)
+	}
+}
+
+func TestRelativeOID_contentCodec(t *testing.T) {
+	rel, _ := NewRelativeOID(`4.1.56521.18446744073709551616`)
+
+	content, err := rel.EncodeContent()
+	if err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	}
+
+	var r RelativeOID
+	if err = r.DecodeContent(content, Strict); err != nil || r.String() != rel.String() {
+		t.Errorf("%s failed: got %s, %v", t.Name(), r, err)
+	}
+
+	var enc []byte
+	if enc, err = rel.EncodeImplicit(2); err != nil {
+		t.Fatalf("%s failed: %v", t.Name(), err)
+	} else if enc[0] != 0x82 {
+		t.Errorf("%s failed: want tag 0x82, got %#x", t.Name(), enc[0])
+	}
+
+	if err = r.DecodeImplicit(enc, 2, Strict); err != nil || r.String() != rel.String() {
+		t.Errorf("%s failed: got %s, %v", t.Name(), r, err)
+	} else if err = r.Decode(enc); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrInvalidTag, err)
+	}
+
+	if _, err = (RelativeOID{}).EncodeImplicit(2); err == nil {
+		t.Errorf("%s failed: expected error for zero length input", t.Name())
+	}
+}
@@ -0,0 +1,145 @@
+package objectid
+
+/*
+resolve.go handles the resolution of name-only arcs.
+*/
+
+/*
+Resolver is a source of [NumberForm] values for name-only arcs, such as
+"dod" within "{iso identified-organization(3) dod 1}". A *[Registry]
+qualifies as a Resolver.
+
+ResolveName returns the [NumberForm] of the arc bearing the input name
+beneath the input parent [DotNotation], alongside a Boolean value
+indicative of success. A zero parent indicates the root.
+*/
+type Resolver interface {
+	ResolveName(DotNotation, string) (NumberForm, bool)
+}
+
+/*
+ResolveName returns the [NumberForm] of the arc bearing the input name,
+whether a primary or secondary identifier, beneath the input parent, as
+well as a Boolean value indicative of success. This satisfies the
+[Resolver] interface. A nil receiver resolves nothing.
+*/
+func (r *Registry) ResolveName(parent DotNotation, name string) (nf NumberForm, ok bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var node *registryNode
+	for i := 0; i < parent.Len(); i++ {
+		if node = r.child(node, parent[i]); node == nil {
+			return
+		}
+	}
+
+	if node = r.childByName(node, name); node != nil {
+		nf, ok = node.nanf.primaryIdentifier, true
+	}
+
+	return
+}
+
+/*
+parseArcs returns an instance of [ASN1Notation] alongside an error following
+an attempt to parse each of the input arc strings. Name-only arcs, excluding
+root abbreviations, are resolved through the first non-nil [Resolver] found
+within resolvers, if any.
+
+The input value is the original string from which arcs was derived, if any,
+and is used to report the offset of an offending arc; see [arcOffsets]. If
+lenient is true, number forms may bear leading zeros.
+*/
+func parseArcs(input string, arcs []string, resolvers []Resolver, lenient bool) (asn ASN1Notation, err error) {
+	var resolver Resolver
+	for i := 0; i < len(resolvers) && resolver == nil; i++ {
+		resolver = resolvers[i]
+	}
+
+	offs := arcOffsets(input, arcs)
+	asn = make(ASN1Notation, 0, len(arcs))
+	for i := 0; i < len(arcs); i++ {
+		if resolver != nil && i > 0 && isIdentifier(arcs[i]) {
+			nf, ok := resolver.ResolveName(asn.numberForms(), arcs[i])
+			if !ok {
+				err = reparse(parseErr(ErrUnresolvedArc, arcs[i], -1, 0), input, i, offs[i])
+				return
+			}
+			asn = append(asn, NameAndNumberForm{
+				identifier:        arcs[i],
+				primaryIdentifier: nf,
+				parsed:            true,
+			})
+			continue
+		}
+
+		var nanf *NameAndNumberForm
+		if nanf, err = parseNaNFOrNF(arcs[i], lenient); err != nil {
+			err = reparse(err, input, i, offs[i])
+			return
+		}
+		nanf.parsed = true
+		asn = append(asn, *nanf)
+	}
+
+	return
+}
+
+/*
+arcOffsets returns the byte offset of each of the input arcs within the
+input string, in order. If input is zero length, an offset of -1 is used
+for each arc.
+*/
+func arcOffsets(input string, arcs []string) (offs []int) {
+	offs = make([]int, len(arcs))
+	for i, pos := 0, 0; i < len(arcs); i++ {
+		if offs[i] = -1; input != `` {
+			if idx := index(input[pos:], arcs[i]); idx >= 0 {
+				offs[i] = pos + idx
+				pos += idx + len(arcs[i])
+			}
+		}
+	}
+
+	return
+}
+
+/*
+NewWellKnownRegistry returns a freshly initialized instance of *[Registry]
+bearing the root arcs as well as a modest selection of well-known arcs,
+such as those beneath {iso(1) identified-organization(3) dod(6) internet(1)}.
+The return instance may be extended further using [Registry.Register].
+*/
+func NewWellKnownRegistry() (r *Registry) {
+	r = NewRegistry()
+	for _, asn := range []string{
+		`{itu-t(0) recommendation(0)}`,
+		`{itu-t(0) data(9) pss(2342) ucl(19200300) pilot(100)}`,
+		`{iso(1) standard(0)}`,
+		`{iso(1) member-body(2) us(840) rsadsi(113549) pkcs(1)}`,
+		`{iso(1) member-body(2) us(840) ansi-x962(10045)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) directory(1)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) mgmt(2) mib-2(1)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) experimental(3)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) snmpV2(6)}`,
+		`{iso(1) identified-organization(3) dod(6) internet(1) mail(7)}`,
+		`{joint-iso-itu-t(2) ds(5) attributeType(4)}`,
+		`{joint-iso-itu-t(2) ds(5) objectClass(6)}`,
+		`{joint-iso-itu-t(2) country(16)}`,
+		`{joint-iso-itu-t(2) uuid(25)}`,
+		`{joint-iso-itu-t(2) example(999)}`,
+	} {
+		// these are known to be valid and
+		// free of conflict.
+		_ = r.Register(asn, ``)
+	}
+
+	return
+}
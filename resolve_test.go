@@ -0,0 +1,57 @@
+package objectid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewOID_withResolver() {
+	reg := NewWellKnownRegistry()
+	id, err := NewOID(`{iso identified-organization(3) dod 1 private enterprise 56521}`, reg)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s\n%s", id, id.Dot())
+	// Output:
+	// {iso(1) identified-organization(3) dod(6) 1 private(4) enterprise(1) 56521}
+	// 1.3.6.1.4.1.56521
+}
+
+func TestResolver(t *testing.T) {
+	reg := NewWellKnownRegistry()
+
+	asn, err := NewASN1Notation([]string{`joint-iso-itu-t`, `uuid`, `1`}, nil, reg)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if asn.String() != `{joint-iso-itu-t(2) uuid(25) 1}` {
+		t.Errorf("%s failed: unexpected %T '%s'", t.Name(), asn, asn)
+	}
+
+	// unresolvable arcs must be named within the error
+	for _, resolver := range []Resolver{reg, nil} {
+		_, err = NewOID(`{iso identified-organization(3) dod bogus 1}`, resolver)
+		if err == nil {
+			t.Errorf("%s failed: bogus arc resolved without error", t.Name())
+		} else if !contains(err.Error(), `'dod'`) && !contains(err.Error(), `'bogus'`) {
+			t.Errorf("%s failed: unhelpful error: %v", t.Name(), err)
+		}
+	}
+
+	if _, err = NewOID(`{iso identified-organization(3) dod 1}`); err == nil {
+		t.Errorf("%s failed: name-only arc resolved without a Resolver", t.Name())
+	}
+
+	// a typed-nil *Registry resolves nothing, rather than panicking
+	var nilReg *Registry
+	if _, err = NewOID(`{iso foo 3}`, nilReg); !errors.Is(err, ErrUnresolvedArc) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrUnresolvedArc, err)
+	}
+
+	if _, ok := reg.ResolveName(*assertDotNot(`1.3.6.1.99`), `bogus`); ok {
+		t.Errorf("%s failed: bogus name resolved", t.Name())
+	}
+}
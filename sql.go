@@ -0,0 +1,132 @@
+package objectid
+
+/*
+sql.go implements the database/sql.Scanner and database/sql/driver.Valuer
+interfaces for the DotNotation and OID types.
+*/
+
+import "database/sql/driver"
+
+/*
+Value returns the dot notation text representation of the receiver (e.g.:
+"1.3.6.1") alongside an error, thereby implementing the [database/sql/driver.Valuer]
+interface. A zero receiver instance is stored as NULL.
+
+In order to store the ASN.1 DER encoding instead, see [DotNotation.DER].
+*/
+func (r DotNotation) Value() (v driver.Value, err error) {
+	if !r.IsZero() {
+		v = r.String()
+	}
+
+	return
+}
+
+/*
+DER returns an instance of [database/sql/driver.Valuer] which stores the
+receiver instance as its ASN.1 DER encoding, such as within a PostgreSQL
+bytea or SQLite BLOB column. For example:
+
+	_, err := db.Exec(`INSERT INTO oids (der) VALUES ($1)`, dot.DER())
+*/
+func (r DotNotation) DER() driver.Valuer {
+	return derValuer(r)
+}
+
+/*
+derValuer stores a [DotNotation] as its ASN.1 DER encoding.
+*/
+type derValuer DotNotation
+
+func (r derValuer) Value() (v driver.Value, err error) {
+	if dot := DotNotation(r); !dot.IsZero() {
+		v, err = dot.Encode()
+	}
+
+	return
+}
+
+/*
+Scan returns an error following an attempt to read src into the receiver
+instance, thereby implementing the [database/sql.Scanner] interface.
+
+Valid src types are string and []byte, each of which may contain either
+the dot notation text representation or the ASN.1 DER encoding (which is
+identified by its leading 0x06 tag). A nil src zeroes the receiver.
+*/
+func (r *DotNotation) Scan(src any) (err error) {
+	switch tv := src.(type) {
+	case nil:
+		*r = make(DotNotation, 0)
+	case string:
+		err = r.UnmarshalText([]byte(tv))
+	case []byte:
+		if len(tv) > 0 && tv[0] == 0x06 {
+			err = r.UnmarshalBinary(tv)
+		} else {
+			err = r.UnmarshalText(tv)
+		}
+	default:
+		err = errorf("Unsupported %T source type '%T'", r, src)
+	}
+
+	return
+}
+
+/*
+Value returns the ASN.1 text representation of the receiver (e.g.: "{iso(1)
+identified-organization(3)}") alongside an error, thereby implementing the
+[database/sql/driver.Valuer] interface. A zero receiver instance is stored
+as NULL.
+
+In order to store the dot notation text instead, use the [DotNotation]
+returned by [OID.Dot]. In order to store the ASN.1 DER encoding, see the
+[OID.DER] method.
+*/
+func (r OID) Value() (v driver.Value, err error) {
+	if !r.IsZero() {
+		v = r.String()
+	}
+
+	return
+}
+
+/*
+DER returns an instance of [database/sql/driver.Valuer] which stores the
+receiver instance as its ASN.1 DER encoding. Note that identifiers are not
+preserved by this representation. See [DotNotation.DER] for details.
+*/
+func (r OID) DER() driver.Valuer {
+	return derValuer(r.Dot())
+}
+
+/*
+Scan returns an error following an attempt to read src into the receiver
+instance, thereby implementing the [database/sql.Scanner] interface.
+
+Valid src types are string and []byte, each of which may contain the ASN.1
+text representation, the dot notation text representation, or the ASN.1
+DER encoding (which is identified by its leading 0x06 tag). A nil src
+zeroes the receiver.
+*/
+func (r *OID) Scan(src any) (err error) {
+	switch tv := src.(type) {
+	case nil:
+		*r = OID{}
+	case string:
+		err = r.unmarshalString(tv)
+	case []byte:
+		if len(tv) > 0 && tv[0] == 0x06 {
+			var dot DotNotation
+			if err = dot.UnmarshalBinary(tv); err == nil {
+				err = r.unmarshalString(dot.String())
+			}
+		} else {
+			err = r.unmarshalString(string(tv))
+		}
+	default:
+		err = errorf("Unsupported %T source type '%T'", r, src)
+	}
+
+	return
+}
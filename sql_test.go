@@ -0,0 +1,108 @@
+package objectid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = &DotNotation{}
+	_ sql.Scanner   = &OID{}
+	_ driver.Valuer = DotNotation{}
+	_ driver.Valuer = OID{}
+)
+
+func ExampleDotNotation_Scan() {
+	var dot DotNotation
+
+	// DER bytes, such as those read from a bytea column
+	if err := dot.Scan([]byte{0x06, 0x03, 0x2b, 0x06, 0x01}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", dot)
+	// Output: 1.3.6.1
+}
+
+func TestDotNotation_SQL(t *testing.T) {
+	in, _ := NewDotNotation(`1.3.6.1.4.1.56521`)
+
+	for _, valuer := range []driver.Valuer{in, in.DER()} {
+		v, err := valuer.Value()
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		}
+
+		var out DotNotation
+		if err = out.Scan(v); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if out.String() != in.String() {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), in, out)
+		}
+
+		// drivers may also hand text over as []byte
+		if s, ok := v.(string); ok {
+			if err = out.Scan([]byte(s)); err != nil || out.String() != in.String() {
+				t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), in, out, err)
+			}
+		}
+	}
+
+	var dot DotNotation
+	for _, valuer := range []driver.Valuer{dot, dot.DER()} {
+		if v, err := valuer.Value(); v != nil || err != nil {
+			t.Errorf("%s failed: expected NULL, got %v (%v)", t.Name(), v, err)
+		}
+	}
+
+	if err := dot.Scan(nil); err != nil || !dot.IsZero() {
+		t.Errorf("%s failed: expected zero %T (%v)", t.Name(), dot, err)
+	}
+
+	for _, bogus := range []any{1, `1..3`, []byte{0x06, 0x05, 0x2b}} {
+		if err := dot.Scan(bogus); err == nil {
+			t.Errorf("%s failed: bogus %T scanned without error", t.Name(), bogus)
+		}
+	}
+}
+
+func TestOID_SQL(t *testing.T) {
+	in, _ := NewOID(`{iso(1) identified-organization(3) dod(6) internet(1)}`)
+
+	for _, src := range []any{
+		`{iso(1) identified-organization(3) dod(6) internet(1)}`,
+		[]byte(`{iso(1) identified-organization(3) dod(6) internet(1)}`),
+		`1.3.6.1`,
+		[]byte{0x06, 0x03, 0x2b, 0x06, 0x01},
+	} {
+		var out OID
+		if err := out.Scan(src); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if !out.Dot().AncestorOf(in.Dot().NewSubordinate(1)) {
+			t.Errorf("%s failed: unexpected %T '%s'", t.Name(), out, out)
+		}
+	}
+
+	if v, err := in.Value(); err != nil || v != in.String() {
+		t.Errorf("%s failed: unexpected value %v (%v)", t.Name(), v, err)
+	}
+
+	if v, err := in.DER().Value(); err != nil || len(v.([]byte)) != 5 {
+		t.Errorf("%s failed: unexpected value %v (%v)", t.Name(), v, err)
+	}
+
+	var id OID
+	if v, err := id.Value(); v != nil || err != nil {
+		t.Errorf("%s failed: expected NULL, got %v (%v)", t.Name(), v, err)
+	} else if err = id.Scan(nil); err != nil || !id.IsZero() {
+		t.Errorf("%s failed: expected zero %T (%v)", t.Name(), id, err)
+	} else if err = id.Scan(1.5); err == nil {
+		t.Errorf("%s failed: bogus type scanned without error", t.Name())
+	} else if err = id.Scan([]byte{0x06, 0x01}); err == nil {
+		t.Errorf("%s failed: bogus DER scanned without error", t.Name())
+	}
+}
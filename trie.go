@@ -0,0 +1,231 @@
+package objectid
+
+/*
+trie.go implements a generic prefix trie keyed by DotNotation.
+*/
+
+import (
+	"iter"
+	"math/big"
+	"slices"
+)
+
+/*
+Trie is a prefix tree which maps [DotNotation] keys to values of type V.
+Each edge is a single [NumberForm] arc, thus arcs of any magnitude are
+supported, and the cost of an operation is proportional to the length of
+the key rather than to the number of keys stored.
+
+A Trie is well-suited to routing by OID prefix, such as locating the most
+specific policy for "anything beneath 1.3.6.1.4.1.56521.101", by way of the
+[Trie.LongestPrefix] method.
+
+The zero value is an empty Trie ready for use, though [NewTrie] may be used
+for convenience. A Trie is not safe for concurrent use without external
+synchronization, in the same manner as a map.
+*/
+type Trie[V any] struct {
+	root  trieNode[V]
+	count int
+}
+
+/*
+trieNode is a single arc within a [Trie].
+*/
+type trieNode[V any] struct {
+	arc      NumberForm
+	value    V
+	set      bool           // whether value was inserted
+	children []*trieNode[V] // ordered by NumberForm
+}
+
+/*
+NewTrie returns a freshly initialized, empty instance of *[Trie].
+*/
+func NewTrie[V any]() *Trie[V] {
+	return new(Trie[V])
+}
+
+/*
+Len returns the integer number of keys within the receiver instance.
+*/
+func (r *Trie[V]) Len() int {
+	return r.count
+}
+
+/*
+Insert associates value with dot within the receiver instance, replacing
+any value previously associated with dot. A Boolean value indicative of
+whether dot was newly inserted is returned.
+
+The arcs of dot are copied, thus dot may be modified or reused by the
+caller following the call.
+*/
+func (r *Trie[V]) Insert(dot DotNotation, value V) (inserted bool) {
+	node := &r.root
+	for i := 0; i < len(dot); i++ {
+		idx, found := node.search(dot[i])
+		if !found {
+			child := new(trieNode[V])
+			(*big.Int)(&child.arc).Set((*big.Int)(&dot[i]))
+			node.children = slices.Insert(node.children, idx, child)
+		}
+		node = node.children[idx]
+	}
+
+	if inserted = !node.set; inserted {
+		r.count++
+	}
+	node.value, node.set = value, true
+
+	return
+}
+
+/*
+Get returns the value associated with dot alongside a Boolean value
+indicative of whether dot was found.
+*/
+func (r *Trie[V]) Get(dot DotNotation) (value V, ok bool) {
+	if node := r.find(dot); node != nil && node.set {
+		value, ok = node.value, true
+	}
+
+	return
+}
+
+/*
+LongestPrefix returns the longest key within the receiver instance which
+is equal to, or an ancestor of, dot, alongside its value and a Boolean
+value indicative of whether any such key was found.
+
+The returned prefix shares storage with dot.
+*/
+func (r *Trie[V]) LongestPrefix(dot DotNotation) (prefix DotNotation, value V, ok bool) {
+	node := &r.root
+	if node.set {
+		prefix, value, ok = dot[:0], node.value, true
+	}
+
+	for i := 0; i < len(dot); i++ {
+		idx, found := node.search(dot[i])
+		if !found {
+			break
+		}
+
+		if node = node.children[idx]; node.set {
+			prefix, value, ok = dot[:i+1], node.value, true
+		}
+	}
+
+	return
+}
+
+/*
+Delete removes dot, and its associated value, from the receiver instance,
+returning a Boolean value indicative of whether dot was found. Descendants
+of dot are not affected.
+*/
+func (r *Trie[V]) Delete(dot DotNotation) (deleted bool) {
+	// retain the path, such that nodes left
+	// without purpose may be pruned.
+	path := make([]*trieNode[V], 1, len(dot)+1)
+	path[0] = &r.root
+	for i := 0; i < len(dot); i++ {
+		idx, found := path[i].search(dot[i])
+		if !found {
+			return
+		}
+		path = append(path, path[i].children[idx])
+	}
+
+	node := path[len(path)-1]
+	if deleted = node.set; !deleted {
+		return
+	}
+
+	var zero V
+	node.value, node.set = zero, false
+	r.count--
+
+	for i := len(path) - 1; i > 0; i-- {
+		if node = path[i]; node.set || len(node.children) > 0 {
+			break
+		}
+
+		parent := path[i-1]
+		idx, _ := parent.search(node.arc)
+		parent.children = slices.Delete(parent.children, idx, idx+1)
+	}
+
+	return
+}
+
+/*
+All returns an iterator over all keys and values within the receiver
+instance, in the order defined by [Compare]. See [Trie.Subtree].
+*/
+func (r *Trie[V]) All() iter.Seq2[DotNotation, V] {
+	return r.Subtree(nil)
+}
+
+/*
+Subtree returns an iterator over the keys and values within the receiver
+instance which are equal to, or descendants of, prefix, in the order defined
+by [Compare]. Each key yielded is a distinct copy.
+
+The receiver instance must not be modified during iteration.
+*/
+func (r *Trie[V]) Subtree(prefix DotNotation) iter.Seq2[DotNotation, V] {
+	return func(yield func(DotNotation, V) bool) {
+		if node := r.find(prefix); node != nil {
+			path := append(make(DotNotation, 0, len(prefix)+8), prefix...)
+			node.walk(path, yield)
+		}
+	}
+}
+
+/*
+find returns the node addressed by dot, or nil if not found.
+*/
+func (r *Trie[V]) find(dot DotNotation) *trieNode[V] {
+	node := &r.root
+	for i := 0; i < len(dot); i++ {
+		idx, found := node.search(dot[i])
+		if !found {
+			return nil
+		}
+		node = node.children[idx]
+	}
+
+	return node
+}
+
+/*
+search returns the index of the child bearing arc nf, or the index at
+which it would be inserted, alongside a Boolean value indicative of
+whether it was found.
+*/
+func (r *trieNode[V]) search(nf NumberForm) (int, bool) {
+	return slices.BinarySearchFunc(r.children, nf, func(n *trieNode[V], nf NumberForm) int {
+		return n.arc.cmp(nf)
+	})
+}
+
+/*
+walk yields the receiver, followed by its descendants in order, each as
+addressed by path. A Boolean value indicative of whether iteration should
+continue is returned.
+*/
+func (r *trieNode[V]) walk(path DotNotation, yield func(DotNotation, V) bool) bool {
+	if r.set && !yield(slices.Clone(path), r.value) {
+		return false
+	}
+
+	for _, child := range r.children {
+		if !child.walk(append(path, child.arc), yield) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,188 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func mustDot(x string) DotNotation {
+	d, err := NewDotNotation(x)
+	if err != nil {
+		panic(err)
+	}
+	return *d
+}
+
+func ExampleTrie_LongestPrefix() {
+	policies := NewTrie[string]()
+	policies.Insert(mustDot(`1.3.6.1.4.1.56521`), `default`)
+	policies.Insert(mustDot(`1.3.6.1.4.1.56521.101`), `restricted`)
+
+	for _, dot := range []string{
+		`1.3.6.1.4.1.56521.101.7.1`,
+		`1.3.6.1.4.1.56521.999`,
+		`1.3.6.1.4.1.99999`,
+	} {
+		if prefix, policy, ok := policies.LongestPrefix(mustDot(dot)); ok {
+			fmt.Printf("%s: %s (via %s)\n", dot, policy, prefix)
+		} else {
+			fmt.Printf("%s: no policy\n", dot)
+		}
+	}
+	// Output:
+	// 1.3.6.1.4.1.56521.101.7.1: restricted (via 1.3.6.1.4.1.56521.101)
+	// 1.3.6.1.4.1.56521.999: default (via 1.3.6.1.4.1.56521)
+	// 1.3.6.1.4.1.99999: no policy
+}
+
+func ExampleTrie_Subtree() {
+	var t Trie[int]
+	for i, dot := range []string{
+		`1.3.6.1.10`,
+		`1.3.6.1.2`,
+		`2.25.329800735698586629295641978511506172918`,
+		`1.3.6.1`,
+		`1.3.7`,
+	} {
+		t.Insert(mustDot(dot), i)
+	}
+
+	for dot, v := range t.Subtree(mustDot(`1.3.6`)) {
+		fmt.Println(dot, v)
+	}
+	// Output:
+	// 1.3.6.1 3
+	// 1.3.6.1.2 1
+	// 1.3.6.1.10 0
+}
+
+func TestTrie(t *testing.T) {
+	trie := NewTrie[int]()
+	keys := []string{
+		`1.3.6.1.4.1.56521`,
+		`1.3.6.1.4.1.56521.101`,
+		`1.3.6.1.4.1.56521.101.2`,
+		`1.3.6.1.4.1.56521.2`,
+		`2.25.329800735698586629295641978511506172918`,
+		`2.25.329800735698586629295641978511506172918.1`,
+		`0.0`,
+	}
+
+	for i, key := range keys {
+		if !trie.Insert(mustDot(key), i) {
+			t.Errorf("%s failed: %s not newly inserted", t.Name(), key)
+		}
+	}
+
+	if trie.Insert(mustDot(keys[0]), 100) || trie.Len() != len(keys) {
+		t.Errorf("%s failed: replacement altered length %d", t.Name(), trie.Len())
+	} else if v, ok := trie.Get(mustDot(keys[0])); !ok || v != 100 {
+		t.Errorf("%s failed: want replaced value 100, got %d (%t)", t.Name(), v, ok)
+	}
+
+	for _, key := range []string{`1.3.6.1.4.1`, `1.3.6.1.4.1.56521.3`, `2.25`} {
+		if _, ok := trie.Get(mustDot(key)); ok {
+			t.Errorf("%s failed: unexpected match for %s", t.Name(), key)
+		}
+	}
+
+	for _, tc := range []struct {
+		dot, prefix string
+	}{
+		{`1.3.6.1.4.1.56521.101.2.9`, `1.3.6.1.4.1.56521.101.2`},
+		{`1.3.6.1.4.1.56521.101.3`, `1.3.6.1.4.1.56521.101`},
+		{`1.3.6.1.4.1.56521.1`, `1.3.6.1.4.1.56521`},
+		{`2.25.329800735698586629295641978511506172918.1.1`, `2.25.329800735698586629295641978511506172918.1`},
+		{`1.3.6.1.4`, ``},
+	} {
+		prefix, _, ok := trie.LongestPrefix(mustDot(tc.dot))
+		if ok != (tc.prefix != ``) || prefix.String() != tc.prefix {
+			t.Errorf("%s failed [%s]: want prefix '%s', got '%s' (%t)", t.Name(), tc.dot, tc.prefix, prefix, ok)
+		}
+	}
+
+	var got []string
+	for dot := range trie.All() {
+		got = append(got, dot.String())
+	}
+	want := `[0.0 1.3.6.1.4.1.56521 1.3.6.1.4.1.56521.2 1.3.6.1.4.1.56521.101 1.3.6.1.4.1.56521.101.2 ` +
+		`2.25.329800735698586629295641978511506172918 2.25.329800735698586629295641978511506172918.1]`
+	if fmt.Sprint(got) != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %v", t.Name(), want, got)
+	}
+
+	// early termination
+	var n int
+	for range trie.All() {
+		if n++; n == 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("%s failed: iteration did not terminate early", t.Name())
+	}
+
+	for range trie.Subtree(mustDot(`1.3.6.1.4.1.56521.3`)) {
+		t.Errorf("%s failed: unexpected iteration of absent subtree", t.Name())
+	}
+}
+
+func TestTrie_Delete(t *testing.T) {
+	var trie Trie[string]
+	trie.Insert(mustDot(`1.3.6.1.4.1`), `a`)
+	trie.Insert(mustDot(`1.3.6.1.4.1.56521.101`), `b`)
+
+	if trie.Delete(mustDot(`1.3.6.1.4.1.56521`)) {
+		t.Errorf("%s failed: deleted intermediate arc", t.Name())
+	} else if !trie.Delete(mustDot(`1.3.6.1.4.1.56521.101`)) || trie.Len() != 1 {
+		t.Errorf("%s failed: deletion failed", t.Name())
+	} else if trie.Delete(mustDot(`1.3.6.1.4.1.56521.101`)) {
+		t.Errorf("%s failed: repeated deletion succeeded", t.Name())
+	} else if node := trie.find(mustDot(`1.3.6.1.4.1`)); node == nil || len(node.children) != 0 {
+		t.Errorf("%s failed: empty branch not pruned", t.Name())
+	}
+
+	if !trie.Delete(mustDot(`1.3.6.1.4.1`)) || trie.Len() != 0 || len(trie.root.children) != 0 {
+		t.Errorf("%s failed: trie not empty following deletion of all keys", t.Name())
+	}
+
+	// the root may itself bear a value.
+	trie.Insert(nil, `root`)
+	if prefix, v, ok := trie.LongestPrefix(mustDot(`2.999`)); !ok || v != `root` || len(prefix) != 0 {
+		t.Errorf("%s failed: unexpected root match %s, %s, %t", t.Name(), prefix, v, ok)
+	} else if !trie.Delete(nil) || trie.Len() != 0 {
+		t.Errorf("%s failed: root deletion failed", t.Name())
+	}
+}
+
+func TestTrie_keyCopy(t *testing.T) {
+	var trie Trie[bool]
+	dot := mustDot(`1.3.6.1`)
+	trie.Insert(dot, true)
+
+	// altering the caller's arcs in place must
+	// not alter the key within the trie.
+	dec := NewDecoder([]byte{0x06, 0x03, 0x2B, 0x06, 0x02})
+	dec.Next()
+	_ = dec.DotNotation(dot)
+
+	if _, ok := trie.Get(mustDot(`1.3.6.1`)); !ok {
+		t.Errorf("%s failed: key altered by caller", t.Name())
+	}
+}
+
+func BenchmarkTrie_LongestPrefix(b *testing.B) {
+	var trie Trie[int]
+	for i := 0; i < 5000; i++ {
+		trie.Insert(mustDot(fmt.Sprintf("1.3.6.1.4.1.%d.%d", 50000+i%100, i)), i)
+	}
+	dot := mustDot(`1.3.6.1.4.1.50042.4242.1.2.3`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, ok := trie.LongestPrefix(dot); !ok {
+			b.Fatal("no match")
+		}
+	}
+}
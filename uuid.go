@@ -0,0 +1,202 @@
+package objectid
+
+/*
+uuid.go implements conversion between UUIDs and OIDs beneath the
+joint-iso-itu-t(2) uuid(25) arc, per ITU-T Rec. X.667.
+*/
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+)
+
+/*
+UUID is a 128-bit Universally Unique Identifier, per ITU-T Rec. X.667
+and RFC 9562.
+*/
+type UUID [16]byte
+
+/*
+String returns the canonical, lowercase hexadecimal string representation
+of the receiver instance (e.g.: "f81d4fae-7dec-11d0-a765-00a0c91e6bf6").
+*/
+func (r UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], r[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], r[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], r[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], r[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], r[10:])
+
+	return string(b[:])
+}
+
+/*
+NumberForm returns the [NumberForm] representation of the receiver, which
+is the 128-bit unsigned integer value of its octets.
+*/
+func (r UUID) NumberForm() NumberForm {
+	return NumberForm(*big.NewInt(0).SetBytes(r[:]))
+}
+
+/*
+Version returns the version number of the receiver, held within the most
+significant four (4) bits of octet 6.
+*/
+func (r UUID) Version() int {
+	return int(r[6] >> 4)
+}
+
+/*
+ParseUUID returns an instance of [UUID] alongside an error following an
+attempt to parse the input canonical string representation, which is
+case-insensitive. An optional "urn:uuid:" prefix is permitted.
+*/
+func ParseUUID(s string) (u UUID, err error) {
+	if len(s) == 45 && eq(s[:9], `urn:uuid:`) {
+		s = s[9:]
+	}
+
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		err = errorf("Invalid UUID '%s': canonical form is xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", s)
+		return
+	}
+
+	x := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err = hex.Decode(u[:], []byte(x)); err != nil {
+		err = errorf("Invalid UUID '%s': %v", s, err)
+	}
+
+	return
+}
+
+/*
+NewRandomUUID returns a random (version 4) instance of [UUID] alongside an
+error, per RFC 9562 section 5.4.
+*/
+func NewRandomUUID() (u UUID, err error) {
+	if _, err = rand.Read(u[:]); err == nil {
+		u[6] = (u[6] & 0x0f) | 0x40 // version 4
+		u[8] = (u[8] & 0x3f) | 0x80 // RFC 9562 variant
+	}
+
+	return
+}
+
+/*
+NewUUIDDotNotation returns a *[DotNotation] of the form "2.25.<integer>"
+alongside an error following an attempt to convert the input UUID value,
+which may be a [UUID], [16]byte, a []byte of length sixteen (16), or a
+canonical UUID string (see [ParseUUID]).
+*/
+func NewUUIDDotNotation(uuid any) (r *DotNotation, err error) {
+	var u UUID
+	if u, err = assertUUID(uuid); err == nil {
+		r = &DotNotation{NumberForm(*big.NewInt(2)), NumberForm(*big.NewInt(25)), u.NumberForm()}
+	}
+
+	return
+}
+
+/*
+NewUUIDOID returns an *[OID] of the form "{joint-iso-itu-t(2) uuid(25)
+<integer>}" alongside an error following an attempt to convert the input
+UUID value. See [NewUUIDDotNotation] for valid input types.
+*/
+func NewUUIDOID(uuid any) (r *OID, err error) {
+	var u UUID
+	if u, err = assertUUID(uuid); err == nil {
+		r = &OID{nanf: uuidASN1Notation(u), parsed: true}
+	}
+
+	return
+}
+
+/*
+NewRandomUUIDDotNotation returns a *[DotNotation] derived from a random
+(version 4) [UUID], alongside an error.
+*/
+func NewRandomUUIDDotNotation() (r *DotNotation, err error) {
+	var u UUID
+	if u, err = NewRandomUUID(); err == nil {
+		r, err = NewUUIDDotNotation(u)
+	}
+
+	return
+}
+
+/*
+NewRandomUUIDOID returns an *[OID] derived from a random (version 4)
+[UUID], alongside an error.
+*/
+func NewRandomUUIDOID() (r *OID, err error) {
+	var u UUID
+	if u, err = NewRandomUUID(); err == nil {
+		r, err = NewUUIDOID(u)
+	}
+
+	return
+}
+
+/*
+UUID returns the [UUID] held within the receiver alongside an error. The
+receiver must be of the form "2.25.<integer>", where the integer does not
+exceed 128 bits in magnitude.
+*/
+func (r DotNotation) UUID() (u UUID, err error) {
+	if r.Len() != 3 || !r[0].Equal(2) || !r[1].Equal(25) {
+		err = errorf("%T '%s' does not reside directly beneath joint-iso-itu-t(2) uuid(25)", r, r)
+		return
+	}
+
+	n := r[2].cast()
+	if n.BitLen() > 128 {
+		err = errorf("%T '%s' leaf arc exceeds 128 bits", r, r)
+		return
+	}
+	n.FillBytes(u[:])
+
+	return
+}
+
+/*
+UUID returns the [UUID] held within the receiver alongside an error. See
+[DotNotation.UUID] for details.
+*/
+func (r OID) UUID() (u UUID, err error) {
+	return r.Dot().UUID()
+}
+
+func uuidASN1Notation(u UUID) ASN1Notation {
+	return ASN1Notation{
+		{identifier: `joint-iso-itu-t`, primaryIdentifier: NumberForm(*big.NewInt(2)), parsed: true},
+		{identifier: `uuid`, primaryIdentifier: NumberForm(*big.NewInt(25)), parsed: true},
+		{primaryIdentifier: u.NumberForm(), parsed: true},
+	}
+}
+
+func assertUUID(uuid any) (u UUID, err error) {
+	switch tv := uuid.(type) {
+	case UUID:
+		u = tv
+	case [16]byte:
+		u = UUID(tv)
+	case []byte:
+		if len(tv) != 16 {
+			err = errorf("Invalid UUID length %d; sixteen (16) octets are required", len(tv))
+			break
+		}
+		copy(u[:], tv)
+	case string:
+		u, err = ParseUUID(tv)
+	default:
+		err = errorf("Unsupported UUID input type %T", uuid)
+	}
+
+	return
+}
@@ -0,0 +1,118 @@
+package objectid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNewUUIDOID() {
+	id, err := NewUUIDOID(`f81d4fae-7dec-11d0-a765-00a0c91e6bf6`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s\n%s", id, id.Dot())
+	// Output:
+	// {joint-iso-itu-t(2) uuid(25) 329800735698586629295641978511506172918}
+	// 2.25.329800735698586629295641978511506172918
+}
+
+func ExampleDotNotation_UUID() {
+	dot, _ := NewDotNotation(`2.25.329800735698586629295641978511506172918`)
+	u, err := dot.UUID()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s (version %d)", u, u.Version())
+	// Output: f81d4fae-7dec-11d0-a765-00a0c91e6bf6 (version 1)
+}
+
+func TestUUID(t *testing.T) {
+	want := `f81d4fae-7dec-11d0-a765-00a0c91e6bf6`
+	u, _ := ParseUUID(want)
+
+	for _, in := range []any{
+		want,
+		`F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6`,
+		`urn:uuid:` + want,
+		u,
+		[16]byte(u),
+		u[:],
+	} {
+		dot, err := NewUUIDDotNotation(in)
+		if err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			continue
+		}
+
+		var got UUID
+		if got, err = dot.UUID(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if got.String() != want {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), want, got)
+		}
+	}
+
+	for _, bogus := range []any{
+		`f81d4fae7dec11d0a76500a0c91e6bf6`,
+		`f81d4fae-7dec-11d0-a765-00a0c91e6bfg`,
+		`f81d4fae_7dec-11d0-a765-00a0c91e6bf6`,
+		make([]byte, 15),
+		1,
+	} {
+		if _, err := NewUUIDOID(bogus); err == nil {
+			t.Errorf("%s failed: expected error for %v", t.Name(), bogus)
+		}
+	}
+
+	for _, bogus := range []string{
+		`2.25`,
+		`2.26.1`,
+		`2.25.1.1`,
+		`1.25.1`,
+		`2.25.340282366920938463463374607431768211456`, // 2^128
+	} {
+		dot, _ := NewDotNotation(bogus)
+		if _, err := dot.UUID(); err == nil {
+			t.Errorf("%s failed: expected error for %s", t.Name(), bogus)
+		}
+	}
+
+	// the smallest and largest UUIDs are both valid
+	for _, valid := range []string{`2.25.0`, `2.25.340282366920938463463374607431768211455`} {
+		dot, _ := NewDotNotation(valid)
+		if u, err := dot.UUID(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+		} else if d, _ := NewUUIDDotNotation(u); d.String() != valid {
+			t.Errorf("%s failed: want %s, got %s", t.Name(), valid, d)
+		}
+	}
+}
+
+func TestNewRandomUUIDOID(t *testing.T) {
+	id, err := NewRandomUUIDOID()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if !id.Valid() || id.Len() != 3 {
+		t.Errorf("%s failed: invalid %T %s", t.Name(), id, id)
+		return
+	}
+
+	u, err := id.UUID()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if u.Version() != 4 || u[8]&0xc0 != 0x80 {
+		t.Errorf("%s failed: unexpected version or variant: %s", t.Name(), u)
+	}
+
+	var dot *DotNotation
+	if dot, err = NewRandomUUIDDotNotation(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if dot.Equal(id.Dot()) {
+		t.Errorf("%s failed: random UUIDs collided", t.Name())
+	}
+}